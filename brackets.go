@@ -0,0 +1,110 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+import (
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// SourceBracketMatchType is a representation of GtkSourceBracketMatchType.
+type SourceBracketMatchType int
+
+const (
+	SOURCE_BRACKET_MATCH_NONE         SourceBracketMatchType = C.GTK_SOURCE_BRACKET_MATCH_NONE
+	SOURCE_BRACKET_MATCH_OUT_OF_RANGE SourceBracketMatchType = C.GTK_SOURCE_BRACKET_MATCH_OUT_OF_RANGE
+	SOURCE_BRACKET_MATCH_NOT_FOUND    SourceBracketMatchType = C.GTK_SOURCE_BRACKET_MATCH_NOT_FOUND
+	SOURCE_BRACKET_MATCH_FOUND        SourceBracketMatchType = C.GTK_SOURCE_BRACKET_MATCH_FOUND
+)
+
+// maxBracketMatchChars is how far FindMatchingBracket searches before giving
+// up with SOURCE_BRACKET_MATCH_OUT_OF_RANGE, the same limit GtkSourceBuffer
+// uses for highlighting.
+const maxBracketMatchChars = 10000
+
+// brackets maps each bracket to its pair.
+var brackets = map[rune]rune{
+	'(': ')', ')': '(',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+	'<': '>', '>': '<',
+}
+
+// OnBracketMatched connects f to the "bracket-matched" signal, which is
+// emitted when the cursor moves next to a bracket while matching brackets
+// are highlighted. iter is the position of the matching bracket and is nil
+// unless state is SOURCE_BRACKET_MATCH_FOUND.
+func (v *SourceBuffer) OnBracketMatched(f func(iter *gtk.TextIter, state SourceBracketMatchType)) glib.SignalHandle {
+	return v.Connect("bracket-matched", func(_ interface{}, iter *gtk.TextIter, state int) {
+		if SourceBracketMatchType(state) != SOURCE_BRACKET_MATCH_FOUND {
+			iter = nil
+		}
+		f(iter, SourceBracketMatchType(state))
+	})
+}
+
+// FindMatchingBracket returns the position of the bracket matching the one
+// at iter. Brackets inside strings and comments only match brackets in the
+// same kind of context, like GtkSourceBuffer does when highlighting.
+func (v *SourceBuffer) FindMatchingBracket(iter *gtk.TextIter) (*gtk.TextIter, SourceBracketMatchType) {
+	base := iter.GetChar()
+	search, ok := brackets[base]
+	if !ok {
+		return nil, SOURCE_BRACKET_MATCH_NONE
+	}
+	forward := base == '(' || base == '[' || base == '{' || base == '<'
+
+	var class string
+	for _, c := range []string{"string", "comment"} {
+		if v.IterHasContextClass(iter, c) {
+			class = c
+			break
+		}
+	}
+	sameContext := func(it *gtk.TextIter) bool {
+		if class != "" {
+			return v.IterHasContextClass(it, class)
+		}
+		return !v.IterHasContextClass(it, "string") && !v.IterHasContextClass(it, "comment")
+	}
+
+	it := *iter
+	depth := 1
+	for n := 0; n < maxBracketMatchChars; n++ {
+		var moved bool
+		if forward {
+			moved = it.ForwardChar() && !it.IsEnd()
+		} else {
+			moved = it.BackwardChar()
+		}
+		if !moved {
+			return nil, SOURCE_BRACKET_MATCH_NOT_FOUND
+		}
+
+		switch c := it.GetChar(); {
+		case c != base && c != search:
+			continue
+		case !sameContext(&it):
+			continue
+		case c == base:
+			depth++
+		default:
+			depth--
+		}
+		if depth == 0 {
+			return &it, SOURCE_BRACKET_MATCH_FOUND
+		}
+	}
+	return nil, SOURCE_BRACKET_MATCH_OUT_OF_RANGE
+}
+
+// FindMatchingBracketAtCursor returns the position of the bracket matching
+// the one after the cursor or, failing that, the one before it.
+func (v *SourceBuffer) FindMatchingBracketAtCursor() (*gtk.TextIter, SourceBracketMatchType) {
+	cursor := v.GetIterAtMark(v.GetInsert())
+	match, state := v.FindMatchingBracket(cursor)
+	if state != SOURCE_BRACKET_MATCH_NONE || !cursor.BackwardChar() {
+		return match, state
+	}
+	return v.FindMatchingBracket(cursor)
+}
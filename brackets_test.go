@@ -0,0 +1,106 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// bracketSource has brackets in code, in a string and in a comment:
+//
+//	f(a, "(", b) /* ) */
+//	int y[2];
+const bracketSource = "f(a, \"(\", b) /* ) */\nint y[2];"
+
+// newHighlightedBuffer returns a C buffer holding text, highlighted so that
+// its context classes are known.
+func newHighlightedBuffer(t *testing.T, text string) *SourceBuffer {
+	t.Helper()
+	lm, err := SourceLanguageManagerGetDefault()
+	if err != nil {
+		t.Fatal(err)
+	}
+	language, err := lm.GetLanguage("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer, err := SourceBufferNewWithLanguage(language)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer.SetText(text)
+	start, end := buffer.GetBounds()
+	buffer.EnsureHighlight(start, end)
+	return buffer
+}
+
+func TestSourceBufferFindMatchingBracket(t *testing.T) {
+	requireGTK(t)
+	buffer := newHighlightedBuffer(t, bracketSource)
+
+	tests := []struct {
+		offset int
+		want   int
+		state  SourceBracketMatchType
+	}{
+		{0, -1, SOURCE_BRACKET_MATCH_NONE},
+		// The parenthesis in the string is skipped.
+		{1, 11, SOURCE_BRACKET_MATCH_FOUND},
+		{11, 1, SOURCE_BRACKET_MATCH_FOUND},
+		// Brackets in strings only match brackets in strings.
+		{6, -1, SOURCE_BRACKET_MATCH_NOT_FOUND},
+		{26, 28, SOURCE_BRACKET_MATCH_FOUND},
+		{28, 26, SOURCE_BRACKET_MATCH_FOUND},
+	}
+	for _, tt := range tests {
+		match, state := buffer.FindMatchingBracket(buffer.GetIterAtOffset(tt.offset))
+		if state != tt.state {
+			t.Errorf("FindMatchingBracket(%d) state = %v, want %v", tt.offset, state, tt.state)
+			continue
+		}
+		if tt.want < 0 {
+			if match != nil {
+				t.Errorf("FindMatchingBracket(%d) = %d, want nil", tt.offset, match.GetOffset())
+			}
+		} else if match == nil || match.GetOffset() != tt.want {
+			t.Errorf("FindMatchingBracket(%d) = %v, want offset %d", tt.offset, match, tt.want)
+		}
+	}
+}
+
+func TestSourceBufferFindMatchingBracketAtCursor(t *testing.T) {
+	requireGTK(t)
+	buffer := newHighlightedBuffer(t, bracketSource)
+
+	// The cursor is after the closing parenthesis, before a space.
+	buffer.PlaceCursor(buffer.GetIterAtOffset(12))
+	match, state := buffer.FindMatchingBracketAtCursor()
+	if state != SOURCE_BRACKET_MATCH_FOUND || match.GetOffset() != 1 {
+		t.Errorf("FindMatchingBracketAtCursor() = %v, %v, want offset 1", match, state)
+	}
+
+	buffer.PlaceCursor(buffer.GetIterAtOffset(22))
+	if _, state := buffer.FindMatchingBracketAtCursor(); state != SOURCE_BRACKET_MATCH_NONE {
+		t.Errorf("FindMatchingBracketAtCursor() state = %v away from brackets, want SOURCE_BRACKET_MATCH_NONE", state)
+	}
+}
+
+func TestSourceBufferOnBracketMatched(t *testing.T) {
+	requireGTK(t)
+	buffer := newHighlightedBuffer(t, bracketSource)
+	buffer.SetHighlightMatchingBrackets(true)
+
+	var got = -1
+	var gotState SourceBracketMatchType
+	buffer.OnBracketMatched(func(iter *gtk.TextIter, state SourceBracketMatchType) {
+		gotState = state
+		if iter != nil {
+			got = iter.GetOffset()
+		}
+	})
+	buffer.PlaceCursor(buffer.GetIterAtOffset(1))
+	runMainLoopUntil(t, func() bool { return got >= 0 })
+	if gotState != SOURCE_BRACKET_MATCH_FOUND || got != 11 {
+		t.Errorf("bracket-matched = %d, %v, want 11, SOURCE_BRACKET_MATCH_FOUND", got, gotState)
+	}
+}
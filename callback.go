@@ -0,0 +1,59 @@
+package sourceview
+
+import "sync"
+
+// Go values handed to C as user data are kept in this registry and referred
+// to by id, since Go pointers must not be stored in C memory.
+var (
+	callbackMutex sync.RWMutex
+	callbacks     = make(map[uintptr]interface{})
+	callbackNext  uintptr
+)
+
+// assignCallback stores v in the registry and returns its id. Ids are never 0,
+// so they can be told apart from a NULL user data pointer.
+func assignCallback(v interface{}) uintptr {
+	callbackMutex.Lock()
+	defer callbackMutex.Unlock()
+
+	callbackNext++
+	callbacks[callbackNext] = v
+	return callbackNext
+}
+
+// getCallback returns the value registered under id, or nil.
+func getCallback(id uintptr) interface{} {
+	callbackMutex.RLock()
+	defer callbackMutex.RUnlock()
+
+	return callbacks[id]
+}
+
+// deleteCallback removes the value registered under id.
+func deleteCallback(id uintptr) {
+	getAndDeleteCallback(id)
+}
+
+// getAndDeleteCallback removes the value registered under id and returns it.
+func getAndDeleteCallback(id uintptr) interface{} {
+	callbackMutex.Lock()
+	defer callbackMutex.Unlock()
+
+	v := callbacks[id]
+	delete(callbacks, id)
+	return v
+}
+
+// findCallback returns the id of the first registered value for which match
+// returns true, or 0.
+func findCallback(match func(interface{}) bool) uintptr {
+	callbackMutex.RLock()
+	defer callbackMutex.RUnlock()
+
+	for id, v := range callbacks {
+		if match(v) {
+			return id
+		}
+	}
+	return 0
+}
@@ -0,0 +1,563 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "completion.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_completion_get_type()), marshalSourceCompletion},
+		{glib.Type(C.gtk_source_completion_context_get_type()), marshalSourceCompletionContext},
+		{glib.Type(C.gtk_source_completion_info_get_type()), marshalSourceCompletionInfo},
+		{glib.Type(C.gtk_source_completion_item_get_type()), marshalSourceCompletionItem},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceCompletion"] = wrapSourceCompletion
+	gtk.WrapMap["GtkSourceCompletionContext"] = wrapSourceCompletionContext
+	gtk.WrapMap["GtkSourceCompletionInfo"] = wrapSourceCompletionInfo
+	gtk.WrapMap["GtkSourceCompletionItem"] = wrapSourceCompletionItem
+}
+
+var errProviderNotFound = errors.New("completion provider was not added")
+
+// GetCompletion is a wrapper around gtk_source_view_get_completion().
+func (v *SourceView) GetCompletion() (*SourceCompletion, error) {
+	c := C.gtk_source_view_get_completion(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceCompletion(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SourceCompletionActivation is a representation of GtkSourceCompletionActivation.
+type SourceCompletionActivation int
+
+const (
+	SOURCE_COMPLETION_ACTIVATION_NONE           SourceCompletionActivation = C.GTK_SOURCE_COMPLETION_ACTIVATION_NONE
+	SOURCE_COMPLETION_ACTIVATION_INTERACTIVE    SourceCompletionActivation = C.GTK_SOURCE_COMPLETION_ACTIVATION_INTERACTIVE
+	SOURCE_COMPLETION_ACTIVATION_USER_REQUESTED SourceCompletionActivation = C.GTK_SOURCE_COMPLETION_ACTIVATION_USER_REQUESTED
+)
+
+/*
+ * GtkSourceCompletion
+ */
+
+// SourceCompletion is a representation of GtkSourceCompletion.
+type SourceCompletion struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceCompletion.
+func (v *SourceCompletion) native() *C.GtkSourceCompletion {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceCompletion(p)
+}
+
+func marshalSourceCompletion(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceCompletion(obj), nil
+}
+
+func wrapSourceCompletion(obj *glib.Object) *SourceCompletion {
+	return &SourceCompletion{obj}
+}
+
+// AddProvider is a wrapper around gtk_source_completion_add_provider().
+func (v *SourceCompletion) AddProvider(provider SourceCompletionProvider) error {
+	p := providerNative(provider)
+	defer C.g_object_unref(C.gpointer(p))
+
+	var err *C.GError
+	if !gobool(C.gtk_source_completion_add_provider(v.native(), p, &err)) {
+		return goError(err)
+	}
+	return nil
+}
+
+// RemoveProvider is a wrapper around gtk_source_completion_remove_provider().
+func (v *SourceCompletion) RemoveProvider(provider SourceCompletionProvider) error {
+	p := lookupProviderNative(provider)
+	if p == nil {
+		return errProviderNotFound
+	}
+
+	var err *C.GError
+	if !gobool(C.gtk_source_completion_remove_provider(v.native(), p, &err)) {
+		return goError(err)
+	}
+	return nil
+}
+
+// GetProviders is a wrapper around gtk_source_completion_get_providers().
+func (v *SourceCompletion) GetProviders() []SourceCompletionProvider {
+	var providers []SourceCompletionProvider
+	for l := C.gtk_source_completion_get_providers(v.native()); l != nil; l = l.next {
+		providers = append(providers, wrapProvider(unsafe.Pointer(l.data)))
+	}
+	return providers
+}
+
+// Show is a wrapper around gtk_source_completion_show().
+func (v *SourceCompletion) Show(providers []SourceCompletionProvider, context *SourceCompletionContext) bool {
+	var list *C.GList
+	for _, provider := range providers {
+		if p := lookupProviderNative(provider); p != nil {
+			list = C.g_list_append(list, C.gpointer(p))
+		}
+	}
+	defer C.g_list_free(list)
+	return gobool(C.gtk_source_completion_show(v.native(), list, context.native()))
+}
+
+// Hide is a wrapper around gtk_source_completion_hide().
+func (v *SourceCompletion) Hide() {
+	C.gtk_source_completion_hide(v.native())
+}
+
+// GetInfoWindow is a wrapper around gtk_source_completion_get_info_window().
+func (v *SourceCompletion) GetInfoWindow() (*SourceCompletionInfo, error) {
+	c := C.gtk_source_completion_get_info_window(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceCompletionInfo(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetView is a wrapper around gtk_source_completion_get_view().
+func (v *SourceCompletion) GetView() (*SourceView, error) {
+	c := C.gtk_source_completion_get_view(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceView(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// CreateContext is a wrapper around gtk_source_completion_create_context().
+func (v *SourceCompletion) CreateContext(position *gtk.TextIter) (*SourceCompletionContext, error) {
+	c := C.gtk_source_completion_create_context(v.native(), (*C.GtkTextIter)(unsafe.Pointer(position)))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceCompletionContext(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// BlockInteractive is a wrapper around gtk_source_completion_block_interactive().
+func (v *SourceCompletion) BlockInteractive() {
+	C.gtk_source_completion_block_interactive(v.native())
+}
+
+// UnblockInteractive is a wrapper around gtk_source_completion_unblock_interactive().
+func (v *SourceCompletion) UnblockInteractive() {
+	C.gtk_source_completion_unblock_interactive(v.native())
+}
+
+/*
+ * GtkSourceCompletionContext
+ */
+
+// SourceCompletionContext is a representation of GtkSourceCompletionContext.
+type SourceCompletionContext struct {
+	glib.InitiallyUnowned
+}
+
+// native returns a pointer to the underlying GtkSourceCompletionContext.
+func (v *SourceCompletionContext) native() *C.GtkSourceCompletionContext {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceCompletionContext(p)
+}
+
+func marshalSourceCompletionContext(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceCompletionContext(obj), nil
+}
+
+func wrapSourceCompletionContext(obj *glib.Object) *SourceCompletionContext {
+	return &SourceCompletionContext{glib.InitiallyUnowned{obj}}
+}
+
+// AddProposals is a wrapper around gtk_source_completion_context_add_proposals().
+func (v *SourceCompletionContext) AddProposals(provider SourceCompletionProvider, proposals []ISourceCompletionProposal, finished bool) {
+	p := lookupProviderNative(provider)
+	if p == nil {
+		return
+	}
+
+	var list *C.GList
+	for _, proposal := range proposals {
+		list = C.g_list_append(list, C.gpointer(proposal.toSourceCompletionProposal()))
+	}
+	defer C.g_list_free(list)
+	C.gtk_source_completion_context_add_proposals(v.native(), p, list, gbool(finished))
+}
+
+// GetIter is a wrapper around gtk_source_completion_context_get_iter().
+func (v *SourceCompletionContext) GetIter() (*gtk.TextIter, bool) {
+	var iter gtk.TextIter
+	ok := C.gtk_source_completion_context_get_iter(v.native(), (*C.GtkTextIter)(unsafe.Pointer(&iter)))
+	return &iter, gobool(ok)
+}
+
+// GetActivation is a wrapper around gtk_source_completion_context_get_activation().
+func (v *SourceCompletionContext) GetActivation() SourceCompletionActivation {
+	c := C.gtk_source_completion_context_get_activation(v.native())
+	return SourceCompletionActivation(c)
+}
+
+/*
+ * GtkSourceCompletionProposal
+ */
+
+// ISourceCompletionProposal is an interface type implemented by all structs
+// embedding a GtkSourceCompletionProposal.  It is meant to be used as an
+// argument type for wrapper functions that wrap around a C GTK function taking
+// a GtkSourceCompletionProposal.
+type ISourceCompletionProposal interface {
+	toSourceCompletionProposal() *C.GtkSourceCompletionProposal
+}
+
+/*
+ * GtkSourceCompletionItem
+ */
+
+// SourceCompletionItem is a representation of GtkSourceCompletionItem.
+type SourceCompletionItem struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceCompletionItem.
+func (v *SourceCompletionItem) native() *C.GtkSourceCompletionItem {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceCompletionItem(p)
+}
+
+func (v *SourceCompletionItem) toSourceCompletionProposal() *C.GtkSourceCompletionProposal {
+	if v == nil {
+		return nil
+	}
+	return C.toGtkSourceCompletionProposal(unsafe.Pointer(v.GObject))
+}
+
+func marshalSourceCompletionItem(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceCompletionItem(obj), nil
+}
+
+func wrapSourceCompletionItem(obj *glib.Object) *SourceCompletionItem {
+	return &SourceCompletionItem{obj}
+}
+
+// SourceCompletionItemNew is a wrapper around gtk_source_completion_item_new2(),
+// or gtk_source_completion_item_new() in GtkSourceView 4.
+func SourceCompletionItemNew() (*SourceCompletionItem, error) {
+	c := completionItemNew()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceCompletionItem(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SetLabel is a wrapper around gtk_source_completion_item_set_label().
+func (v *SourceCompletionItem) SetLabel(label string) {
+	cstr := C.CString(label)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_completion_item_set_label(v.native(), (*C.gchar)(cstr))
+}
+
+// SetMarkup is a wrapper around gtk_source_completion_item_set_markup().
+func (v *SourceCompletionItem) SetMarkup(markup string) {
+	cstr := C.CString(markup)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_completion_item_set_markup(v.native(), (*C.gchar)(cstr))
+}
+
+// SetText is a wrapper around gtk_source_completion_item_set_text().
+func (v *SourceCompletionItem) SetText(text string) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_completion_item_set_text(v.native(), (*C.gchar)(cstr))
+}
+
+// SetIcon is a wrapper around gtk_source_completion_item_set_icon().
+// Passing nil clears the icon.
+func (v *SourceCompletionItem) SetIcon(icon *gdk.Pixbuf) {
+	var c *C.GdkPixbuf
+	if icon != nil {
+		c = (*C.GdkPixbuf)(unsafe.Pointer(icon.Native()))
+	}
+	C.gtk_source_completion_item_set_icon(v.native(), c)
+}
+
+// SetIconName is a wrapper around gtk_source_completion_item_set_icon_name().
+func (v *SourceCompletionItem) SetIconName(name string) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_completion_item_set_icon_name(v.native(), (*C.gchar)(cstr))
+}
+
+// SetGIcon is a wrapper around gtk_source_completion_item_set_gicon().
+// Passing nil clears the icon.
+func (v *SourceCompletionItem) SetGIcon(icon *glib.Icon) {
+	var c *C.GIcon
+	if icon != nil {
+		c = (*C.GIcon)(unsafe.Pointer(icon.Native()))
+	}
+	C.gtk_source_completion_item_set_gicon(v.native(), c)
+}
+
+// SetInfo is a wrapper around gtk_source_completion_item_set_info().
+func (v *SourceCompletionItem) SetInfo(info string) {
+	cstr := C.CString(info)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_completion_item_set_info(v.native(), (*C.gchar)(cstr))
+}
+
+// GetLabel is a wrapper around gtk_source_completion_proposal_get_label().
+func (v *SourceCompletionItem) GetLabel() string {
+	c := C.gtk_source_completion_proposal_get_label(v.toSourceCompletionProposal())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetMarkup is a wrapper around gtk_source_completion_proposal_get_markup().
+func (v *SourceCompletionItem) GetMarkup() string {
+	c := C.gtk_source_completion_proposal_get_markup(v.toSourceCompletionProposal())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetText is a wrapper around gtk_source_completion_proposal_get_text().
+func (v *SourceCompletionItem) GetText() string {
+	c := C.gtk_source_completion_proposal_get_text(v.toSourceCompletionProposal())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetInfo is a wrapper around gtk_source_completion_proposal_get_info().
+func (v *SourceCompletionItem) GetInfo() string {
+	c := C.gtk_source_completion_proposal_get_info(v.toSourceCompletionProposal())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+/*
+ * GtkSourceCompletionInfo
+ */
+
+// SourceCompletionInfo is a representation of GtkSourceCompletionInfo.
+type SourceCompletionInfo struct {
+	gtk.Window
+}
+
+// native returns a pointer to the underlying GtkSourceCompletionInfo.
+func (v *SourceCompletionInfo) native() *C.GtkSourceCompletionInfo {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceCompletionInfo(p)
+}
+
+func marshalSourceCompletionInfo(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceCompletionInfo(obj), nil
+}
+
+func wrapSourceCompletionInfo(obj *glib.Object) *SourceCompletionInfo {
+	return &SourceCompletionInfo{gtk.Window{gtk.Bin{gtk.Container{gtk.Widget{glib.InitiallyUnowned{obj}}}}}}
+}
+
+// SourceCompletionInfoNew is a wrapper around gtk_source_completion_info_new().
+func SourceCompletionInfoNew() (*SourceCompletionInfo, error) {
+	c := C.gtk_source_completion_info_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceCompletionInfo(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// MoveToIter is a wrapper around gtk_source_completion_info_move_to_iter().
+func (v *SourceCompletionInfo) MoveToIter(view *SourceView, iter *gtk.TextIter) {
+	C.gtk_source_completion_info_move_to_iter(v.native(), view.asTextView(),
+		(*C.GtkTextIter)(unsafe.Pointer(iter)))
+}
+
+/*
+ * GtkSourceCompletionProvider
+ */
+
+// SourceCompletionProvider is the Go side of the GtkSourceCompletionProvider
+// GInterface. Implement it in Go and pass it to SourceCompletion.AddProvider;
+// the provider must be comparable (usually a pointer), because it is looked up
+// again by RemoveProvider and SourceCompletionContext.AddProposals.
+type SourceCompletionProvider interface {
+	// GetName returns the name shown in the completion window header.
+	GetName() string
+
+	// GetIcon returns the icon shown next to the name, or nil.
+	GetIcon() *gdk.Pixbuf
+
+	// Populate adds proposals to context with
+	// SourceCompletionContext.AddProposals, and must eventually call it with
+	// finished set to true.
+	Populate(context *SourceCompletionContext)
+
+	// Match reports whether the provider should be used for context.
+	Match(context *SourceCompletionContext) bool
+
+	// GetActivation returns when the provider is activated.
+	GetActivation() SourceCompletionActivation
+
+	// GetInteractiveDelay returns the delay in milliseconds before
+	// interactive completion starts, or -1 for the default.
+	GetInteractiveDelay() int
+
+	// GetPriority returns the sort priority among providers.
+	GetPriority() int
+}
+
+// goCompletionProvider is the registry entry for a SourceCompletionProvider
+// implemented in Go and the GoCompletionProvider instance that wraps it.
+type goCompletionProvider struct {
+	provider SourceCompletionProvider
+	native   *C.GtkSourceCompletionProvider
+}
+
+// lookupProviderNative returns the GtkSourceCompletionProvider for provider
+// without creating one, or nil.
+func lookupProviderNative(provider SourceCompletionProvider) *C.GtkSourceCompletionProvider {
+	if p, ok := provider.(interface {
+		toSourceCompletionProvider() *C.GtkSourceCompletionProvider
+	}); ok {
+		return p.toSourceCompletionProvider()
+	}
+
+	id := findCallback(func(v interface{}) bool {
+		e, ok := v.(*goCompletionProvider)
+		return ok && e.provider == provider
+	})
+	if id == 0 {
+		return nil
+	}
+	return getCallback(id).(*goCompletionProvider).native
+}
+
+// providerNative returns a new reference to the GtkSourceCompletionProvider
+// for provider, wrapping it in a GoCompletionProvider if needed.
+func providerNative(provider SourceCompletionProvider) *C.GtkSourceCompletionProvider {
+	if p := lookupProviderNative(provider); p != nil {
+		C.g_object_ref(C.gpointer(p))
+		return p
+	}
+
+	e := &goCompletionProvider{provider: provider}
+	e.native = C.go_completion_provider_new(C.guintptr(assignCallback(e)))
+	return e.native
+}
+
+// wrapProvider returns the SourceCompletionProvider behind a
+// GtkSourceCompletionProvider pointer.
+func wrapProvider(p unsafe.Pointer) SourceCompletionProvider {
+	if gobool(C.isGoCompletionProvider(p)) {
+		id := uintptr(C.goCompletionProviderID(p))
+		if e, ok := getCallback(id).(*goCompletionProvider); ok {
+			return e.provider
+		}
+	}
+	return &sourceCompletionProvider{glib.Take(p)}
+}
+
+// sourceCompletionProvider wraps a GtkSourceCompletionProvider implemented in
+// C, such as one returned by SourceCompletion.GetProviders.
+type sourceCompletionProvider struct {
+	*glib.Object
+}
+
+func (v *sourceCompletionProvider) toSourceCompletionProvider() *C.GtkSourceCompletionProvider {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	return C.toGtkSourceCompletionProvider(unsafe.Pointer(v.GObject))
+}
+
+// GetName is a wrapper around gtk_source_completion_provider_get_name().
+func (v *sourceCompletionProvider) GetName() string {
+	c := C.gtk_source_completion_provider_get_name(v.toSourceCompletionProvider())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetIcon is a wrapper around gtk_source_completion_provider_get_icon().
+func (v *sourceCompletionProvider) GetIcon() *gdk.Pixbuf {
+	c := C.gtk_source_completion_provider_get_icon(v.toSourceCompletionProvider())
+	if c == nil {
+		return nil
+	}
+	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}
+}
+
+// Populate is a wrapper around gtk_source_completion_provider_populate().
+func (v *sourceCompletionProvider) Populate(context *SourceCompletionContext) {
+	C.gtk_source_completion_provider_populate(v.toSourceCompletionProvider(), context.native())
+}
+
+// Match is a wrapper around gtk_source_completion_provider_match().
+func (v *sourceCompletionProvider) Match(context *SourceCompletionContext) bool {
+	c := C.gtk_source_completion_provider_match(v.toSourceCompletionProvider(), context.native())
+	return gobool(c)
+}
+
+// GetActivation is a wrapper around gtk_source_completion_provider_get_activation().
+func (v *sourceCompletionProvider) GetActivation() SourceCompletionActivation {
+	c := C.gtk_source_completion_provider_get_activation(v.toSourceCompletionProvider())
+	return SourceCompletionActivation(c)
+}
+
+// GetInteractiveDelay is a wrapper around gtk_source_completion_provider_get_interactive_delay().
+func (v *sourceCompletionProvider) GetInteractiveDelay() int {
+	c := C.gtk_source_completion_provider_get_interactive_delay(v.toSourceCompletionProvider())
+	return int(c)
+}
+
+// GetPriority is a wrapper around gtk_source_completion_provider_get_priority().
+func (v *sourceCompletionProvider) GetPriority() int {
+	c := C.gtk_source_completion_provider_get_priority(v.toSourceCompletionProvider())
+	return int(c)
+}
@@ -0,0 +1,174 @@
+#include <stdlib.h>
+#include <gtk/gtk.h>
+
+static GtkSourceCompletion *
+toGtkSourceCompletion(void *p)
+{
+	return (GTK_SOURCE_COMPLETION(p));
+}
+
+static GtkSourceCompletionContext *
+toGtkSourceCompletionContext(void *p)
+{
+	return (GTK_SOURCE_COMPLETION_CONTEXT(p));
+}
+
+static GtkSourceCompletionItem *
+toGtkSourceCompletionItem(void *p)
+{
+	return (GTK_SOURCE_COMPLETION_ITEM(p));
+}
+
+static GtkSourceCompletionInfo *
+toGtkSourceCompletionInfo(void *p)
+{
+	return (GTK_SOURCE_COMPLETION_INFO(p));
+}
+
+static GtkSourceCompletionProposal *
+toGtkSourceCompletionProposal(void *p)
+{
+	return (GTK_SOURCE_COMPLETION_PROPOSAL(p));
+}
+
+static GtkSourceCompletionProvider *
+toGtkSourceCompletionProvider(void *p)
+{
+	return (GTK_SOURCE_COMPLETION_PROVIDER(p));
+}
+
+/*
+ * GoCompletionProvider is a GObject implementing GtkSourceCompletionProvider
+ * whose virtual functions dispatch to a Go SourceCompletionProvider.
+ */
+
+extern gchar *goCompletionProviderGetName(guintptr id);
+extern GdkPixbuf *goCompletionProviderGetIcon(guintptr id);
+extern void goCompletionProviderPopulate(guintptr id, GtkSourceCompletionContext *context);
+extern gboolean goCompletionProviderMatch(guintptr id, GtkSourceCompletionContext *context);
+extern GtkSourceCompletionActivation goCompletionProviderGetActivation(guintptr id);
+extern gint goCompletionProviderGetInteractiveDelay(guintptr id);
+extern gint goCompletionProviderGetPriority(guintptr id);
+extern void goCompletionProviderFinalize(guintptr id);
+
+typedef struct {
+	GObject parent_instance;
+	guintptr id;
+	GdkPixbuf *icon;
+} GoCompletionProvider;
+
+typedef struct {
+	GObjectClass parent_class;
+} GoCompletionProviderClass;
+
+static void go_completion_provider_iface_init(GtkSourceCompletionProviderIface *iface);
+
+G_DEFINE_TYPE_WITH_CODE(GoCompletionProvider, go_completion_provider, G_TYPE_OBJECT,
+	G_IMPLEMENT_INTERFACE(GTK_SOURCE_TYPE_COMPLETION_PROVIDER,
+		go_completion_provider_iface_init))
+
+static gchar *
+go_completion_provider_get_name(GtkSourceCompletionProvider *provider)
+{
+	GoCompletionProvider *self = (GoCompletionProvider *)provider;
+	gchar *name = goCompletionProviderGetName(self->id);
+	gchar *ret = g_strdup(name);
+	free(name);
+	return ret;
+}
+
+static GdkPixbuf *
+go_completion_provider_get_icon(GtkSourceCompletionProvider *provider)
+{
+	GoCompletionProvider *self = (GoCompletionProvider *)provider;
+	// goCompletionProviderGetIcon returns a new reference, which the
+	// instance keeps since get_icon is transfer-none.
+	GdkPixbuf *icon = goCompletionProviderGetIcon(self->id);
+	g_clear_object(&self->icon);
+	self->icon = icon;
+	return self->icon;
+}
+
+static void
+go_completion_provider_populate(GtkSourceCompletionProvider *provider,
+                                GtkSourceCompletionContext *context)
+{
+	goCompletionProviderPopulate(((GoCompletionProvider *)provider)->id, context);
+}
+
+static gboolean
+go_completion_provider_match(GtkSourceCompletionProvider *provider,
+                             GtkSourceCompletionContext *context)
+{
+	return goCompletionProviderMatch(((GoCompletionProvider *)provider)->id, context);
+}
+
+static GtkSourceCompletionActivation
+go_completion_provider_get_activation(GtkSourceCompletionProvider *provider)
+{
+	return goCompletionProviderGetActivation(((GoCompletionProvider *)provider)->id);
+}
+
+static gint
+go_completion_provider_get_interactive_delay(GtkSourceCompletionProvider *provider)
+{
+	return goCompletionProviderGetInteractiveDelay(((GoCompletionProvider *)provider)->id);
+}
+
+static gint
+go_completion_provider_get_priority(GtkSourceCompletionProvider *provider)
+{
+	return goCompletionProviderGetPriority(((GoCompletionProvider *)provider)->id);
+}
+
+static void
+go_completion_provider_iface_init(GtkSourceCompletionProviderIface *iface)
+{
+	iface->get_name = go_completion_provider_get_name;
+	iface->get_icon = go_completion_provider_get_icon;
+	iface->populate = go_completion_provider_populate;
+	iface->match = go_completion_provider_match;
+	iface->get_activation = go_completion_provider_get_activation;
+	iface->get_interactive_delay = go_completion_provider_get_interactive_delay;
+	iface->get_priority = go_completion_provider_get_priority;
+}
+
+static void
+go_completion_provider_finalize(GObject *object)
+{
+	GoCompletionProvider *self = (GoCompletionProvider *)object;
+	g_clear_object(&self->icon);
+	goCompletionProviderFinalize(self->id);
+	G_OBJECT_CLASS(go_completion_provider_parent_class)->finalize(object);
+}
+
+static void
+go_completion_provider_class_init(GoCompletionProviderClass *klass)
+{
+	G_OBJECT_CLASS(klass)->finalize = go_completion_provider_finalize;
+}
+
+static void
+go_completion_provider_init(GoCompletionProvider *self)
+{
+}
+
+static GtkSourceCompletionProvider *
+go_completion_provider_new(guintptr id)
+{
+	GoCompletionProvider *self = g_object_new(go_completion_provider_get_type(), NULL);
+	self->id = id;
+	return GTK_SOURCE_COMPLETION_PROVIDER(self);
+}
+
+static gboolean
+isGoCompletionProvider(void *p)
+{
+	return G_TYPE_CHECK_INSTANCE_TYPE(p, go_completion_provider_get_type());
+}
+
+static guintptr
+goCompletionProviderID(void *p)
+{
+	return ((GoCompletionProvider *)p)->id;
+}
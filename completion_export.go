@@ -0,0 +1,67 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func completionProvider(id C.guintptr) SourceCompletionProvider {
+	return getCallback(uintptr(id)).(*goCompletionProvider).provider
+}
+
+//export goCompletionProviderGetName
+func goCompletionProviderGetName(id C.guintptr) *C.gchar {
+	return (*C.gchar)(C.CString(completionProvider(id).GetName()))
+}
+
+// goCompletionProviderGetIcon returns a new reference to the icon, so that
+// it outlives the Go wrapper even if the provider built it for this call.
+//
+//export goCompletionProviderGetIcon
+func goCompletionProviderGetIcon(id C.guintptr) *C.GdkPixbuf {
+	icon := completionProvider(id).GetIcon()
+	if icon == nil {
+		return nil
+	}
+	c := (*C.GdkPixbuf)(unsafe.Pointer(icon.Native()))
+	C.g_object_ref(C.gpointer(c))
+	runtime.KeepAlive(icon)
+	return c
+}
+
+//export goCompletionProviderPopulate
+func goCompletionProviderPopulate(id C.guintptr, context *C.GtkSourceCompletionContext) {
+	ctx := wrapSourceCompletionContext(glib.Take(unsafe.Pointer(context)))
+	completionProvider(id).Populate(ctx)
+}
+
+//export goCompletionProviderMatch
+func goCompletionProviderMatch(id C.guintptr, context *C.GtkSourceCompletionContext) C.gboolean {
+	ctx := wrapSourceCompletionContext(glib.Take(unsafe.Pointer(context)))
+	return gbool(completionProvider(id).Match(ctx))
+}
+
+//export goCompletionProviderGetActivation
+func goCompletionProviderGetActivation(id C.guintptr) C.GtkSourceCompletionActivation {
+	return C.GtkSourceCompletionActivation(completionProvider(id).GetActivation())
+}
+
+//export goCompletionProviderGetInteractiveDelay
+func goCompletionProviderGetInteractiveDelay(id C.guintptr) C.gint {
+	return C.gint(completionProvider(id).GetInteractiveDelay())
+}
+
+//export goCompletionProviderGetPriority
+func goCompletionProviderGetPriority(id C.guintptr) C.gint {
+	return C.gint(completionProvider(id).GetPriority())
+}
+
+//export goCompletionProviderFinalize
+func goCompletionProviderFinalize(id C.guintptr) {
+	deleteCallback(uintptr(id))
+}
@@ -0,0 +1,154 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// testProvider is a completion provider proposing fixed words.
+type testProvider struct {
+	words     []string
+	populated int
+	err       error
+}
+
+func (p *testProvider) GetName() string      { return "Test" }
+func (p *testProvider) GetIcon() *gdk.Pixbuf { return nil }
+func (p *testProvider) GetPriority() int     { return 3 }
+
+func (p *testProvider) GetActivation() SourceCompletionActivation {
+	return SOURCE_COMPLETION_ACTIVATION_USER_REQUESTED
+}
+
+func (p *testProvider) GetInteractiveDelay() int { return -1 }
+
+func (p *testProvider) Match(context *SourceCompletionContext) bool { return true }
+
+func (p *testProvider) Populate(context *SourceCompletionContext) {
+	p.populated++
+	var proposals []ISourceCompletionProposal
+	for _, word := range p.words {
+		item, err := SourceCompletionItemNew()
+		if err != nil {
+			p.err = err
+			return
+		}
+		item.SetLabel(word)
+		item.SetText(word)
+		proposals = append(proposals, item)
+	}
+	context.AddProposals(p, proposals, true)
+}
+
+func TestSourceCompletionItem(t *testing.T) {
+	requireGTK(t)
+
+	item, err := SourceCompletionItemNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := item.GetLabel(); got != "" {
+		t.Errorf("GetLabel() = %q for a new item, want \"\"", got)
+	}
+	item.SetLabel("label")
+	item.SetMarkup("<b>markup</b>")
+	item.SetText("text")
+	item.SetInfo("info")
+	item.SetIconName("text-x-generic")
+
+	for _, tt := range []struct{ name, got, want string }{
+		{"GetLabel", item.GetLabel(), "label"},
+		{"GetMarkup", item.GetMarkup(), "<b>markup</b>"},
+		{"GetText", item.GetText(), "text"},
+		{"GetInfo", item.GetInfo(), "info"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s() = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestSourceCompletionProviders(t *testing.T) {
+	requireGTK(t)
+
+	view, err := SourceViewNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	completion, err := view.GetCompletion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := completion.GetView(); err != nil || got.Native() != view.Native() {
+		t.Errorf("GetView() = %v, %v, want the view", got, err)
+	}
+
+	provider := &testProvider{}
+	if err := completion.AddProvider(provider); err != nil {
+		t.Fatal(err)
+	}
+	if err := completion.AddProvider(provider); err == nil {
+		t.Error("AddProvider() of a provider already added succeeded")
+	}
+	providers := completion.GetProviders()
+	if len(providers) != 1 || providers[0] != SourceCompletionProvider(provider) {
+		t.Errorf("GetProviders() = %v, want the test provider", providers)
+	}
+
+	if err := completion.RemoveProvider(provider); err != nil {
+		t.Fatal(err)
+	}
+	if got := completion.GetProviders(); len(got) != 0 {
+		t.Errorf("GetProviders() = %v after RemoveProvider, want none", got)
+	}
+	if err := completion.RemoveProvider(provider); err == nil {
+		t.Error("RemoveProvider() of a removed provider succeeded")
+	}
+}
+
+func TestSourceCompletionShow(t *testing.T) {
+	requireGTK(t)
+
+	buffer := newTestBuffer(t, "int value;\nval")
+	view, err := SourceViewNewWithBuffer(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	window, err := gtk.OffscreenWindowNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer window.Destroy()
+	window.Add(view)
+	window.ShowAll()
+
+	completion, err := view.GetCompletion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := &testProvider{words: []string{"value", "variable"}}
+	if err := completion.AddProvider(provider); err != nil {
+		t.Fatal(err)
+	}
+
+	end := buffer.GetEndIter()
+	context, err := completion.CreateContext(end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iter, ok := context.GetIter(); !ok || iter.GetOffset() != end.GetOffset() {
+		t.Errorf("GetIter() = %d, %v, want %d, true", iter.GetOffset(), ok, end.GetOffset())
+	}
+
+	completion.Show([]SourceCompletionProvider{provider}, context)
+	runMainLoopUntil(t, func() bool { return provider.populated > 0 })
+	if provider.err != nil {
+		t.Fatal(provider.err)
+	}
+	completion.Hide()
+
+	completion.BlockInteractive()
+	completion.UnblockInteractive()
+}
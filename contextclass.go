@@ -0,0 +1,96 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+import "C"
+import (
+	"sort"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// IterHasContextClass is a wrapper around gtk_source_buffer_iter_has_context_class().
+func (v *SourceBuffer) IterHasContextClass(iter *gtk.TextIter, contextClass string) bool {
+	cstr := C.CString(contextClass)
+	defer C.free(unsafe.Pointer(cstr))
+	return gobool(C.gtk_source_buffer_iter_has_context_class(v.native(), textIter(iter), (*C.gchar)(cstr)))
+}
+
+// GetContextClassesAtIter is a wrapper around gtk_source_buffer_get_context_classes_at_iter().
+func (v *SourceBuffer) GetContextClassesAtIter(iter *gtk.TextIter) []string {
+	c := C.gtk_source_buffer_get_context_classes_at_iter(v.native(), textIter(iter))
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// IterForwardToContextClassToggle is a wrapper around gtk_source_buffer_iter_forward_to_context_class_toggle().
+// It moves iter forward to the next position where contextClass starts or
+// ends, and returns false if there is none.
+func (v *SourceBuffer) IterForwardToContextClassToggle(iter *gtk.TextIter, contextClass string) bool {
+	cstr := C.CString(contextClass)
+	defer C.free(unsafe.Pointer(cstr))
+	return gobool(C.gtk_source_buffer_iter_forward_to_context_class_toggle(v.native(), textIter(iter), (*C.gchar)(cstr)))
+}
+
+// IterBackwardToContextClassToggle is a wrapper around gtk_source_buffer_iter_backward_to_context_class_toggle().
+// It moves iter backward to the previous position where contextClass starts
+// or ends, and returns false if there is none.
+func (v *SourceBuffer) IterBackwardToContextClassToggle(iter *gtk.TextIter, contextClass string) bool {
+	cstr := C.CString(contextClass)
+	defer C.free(unsafe.Pointer(cstr))
+	return gobool(C.gtk_source_buffer_iter_backward_to_context_class_toggle(v.native(), textIter(iter), (*C.gchar)(cstr)))
+}
+
+// ForEachContextClassSpan splits the range between start and end into spans
+// of text sharing the same context classes, such as "comment" or "string",
+// and calls f for each of them in order. Spans without any class are
+// reported with empty classes. Iteration stops early if f returns false.
+//
+// The range is highlighted first, so the classes are up to date. The iters
+// passed to f are copies and may be kept.
+func (v *SourceBuffer) ForEachContextClassSpan(start, end *gtk.TextIter, f func(start, end *gtk.TextIter, classes []string) bool) {
+	v.EnsureHighlight(start, end)
+
+	spanStart := *start
+	classes := v.GetContextClassesAtIter(&spanStart)
+	iter := spanStart
+	for iter.Compare(end) < 0 {
+		// Context classes are implemented with text tags, so a class can
+		// only change where some tag toggles.
+		if !iter.ForwardToTagToggle(nil) || iter.Compare(end) > 0 {
+			iter = *end
+		}
+
+		var next []string
+		if iter.Compare(end) < 0 {
+			next = v.GetContextClassesAtIter(&iter)
+			if sameClasses(classes, next) {
+				continue
+			}
+		}
+
+		s, e := spanStart, iter
+		if !f(&s, &e, classes) {
+			return
+		}
+		spanStart, classes = iter, next
+	}
+}
+
+// sameClasses reports whether a and b hold the same classes in any order.
+func sameClasses(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func hasClass(classes []string, class string) bool {
+	for _, c := range classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSourceBufferContextClasses(t *testing.T) {
+	requireGTK(t)
+	buffer := newHighlightedBuffer(t, bracketSource)
+
+	if !buffer.IterHasContextClass(buffer.GetIterAtOffset(6), "string") {
+		t.Error("IterHasContextClass(6, \"string\") = false inside the string")
+	}
+	if buffer.IterHasContextClass(buffer.GetIterAtOffset(2), "string") {
+		t.Error("IterHasContextClass(2, \"string\") = true outside the string")
+	}
+	if classes := buffer.GetContextClassesAtIter(buffer.GetIterAtOffset(16)); !hasClass(classes, "comment") {
+		t.Errorf("GetContextClassesAtIter(16) = %q, want it to include \"comment\"", classes)
+	}
+
+	iter := buffer.GetStartIter()
+	if !buffer.IterForwardToContextClassToggle(iter, "comment") || iter.GetOffset() != 13 {
+		t.Errorf("IterForwardToContextClassToggle() moved to %d, want 13", iter.GetOffset())
+	}
+	iter = buffer.GetEndIter()
+	if !buffer.IterBackwardToContextClassToggle(iter, "comment") || iter.GetOffset() != 20 {
+		t.Errorf("IterBackwardToContextClassToggle() moved to %d, want 20", iter.GetOffset())
+	}
+	iter = buffer.GetEndIter()
+	if buffer.IterForwardToContextClassToggle(iter, "comment") {
+		t.Error("IterForwardToContextClassToggle() = true at the end of the buffer")
+	}
+}
+
+func TestSourceBufferForEachContextClassSpan(t *testing.T) {
+	requireGTK(t)
+	buffer := newHighlightedBuffer(t, bracketSource)
+
+	type span struct {
+		start, end int
+		class      string
+	}
+	var spans []span
+	var next int
+	start, end := buffer.GetBounds()
+	buffer.ForEachContextClassSpan(start, end, func(start, end *gtk.TextIter, classes []string) bool {
+		if start.GetOffset() != next {
+			t.Errorf("span starts at %d, want %d right after the previous one", start.GetOffset(), next)
+		}
+		next = end.GetOffset()
+		for _, class := range []string{"string", "comment"} {
+			if hasClass(classes, class) {
+				spans = append(spans, span{start.GetOffset(), end.GetOffset(), class})
+			}
+		}
+		return true
+	})
+	if want := len(bracketSource); next != want {
+		t.Errorf("last span ends at %d, want %d", next, want)
+	}
+	want := []span{{5, 8, "string"}, {13, 20, "comment"}}
+	if len(spans) != len(want) {
+		t.Fatalf("spans = %v, want %v", spans, want)
+	}
+	for i := range want {
+		if spans[i] != want[i] {
+			t.Errorf("span %d = %v, want %v", i, spans[i], want[i])
+		}
+	}
+
+	var n int
+	buffer.ForEachContextClassSpan(start, end, func(start, end *gtk.TextIter, classes []string) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("ForEachContextClassSpan() called f %d times after it returned false, want 1", n)
+	}
+}
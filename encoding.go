@@ -0,0 +1,105 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_encoding_get_type()), marshalSourceEncoding},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GtkSourceEncoding
+ */
+
+// SourceEncoding is a representation of GtkSourceEncoding. Encodings are
+// static data owned by GtkSourceView and never need to be freed.
+type SourceEncoding struct {
+	GtkSourceEncoding *C.GtkSourceEncoding
+}
+
+// native returns a pointer to the underlying GtkSourceEncoding.
+func (v *SourceEncoding) native() *C.GtkSourceEncoding {
+	if v == nil {
+		return nil
+	}
+	return v.GtkSourceEncoding
+}
+
+func marshalSourceEncoding(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	return wrapSourceEncoding((*C.GtkSourceEncoding)(unsafe.Pointer(c))), nil
+}
+
+func wrapSourceEncoding(c *C.GtkSourceEncoding) *SourceEncoding {
+	if c == nil {
+		return nil
+	}
+	return &SourceEncoding{c}
+}
+
+// encodingList converts a GSList of GtkSourceEncoding and frees the list.
+func encodingList(list *C.GSList) []*SourceEncoding {
+	var encodings []*SourceEncoding
+	for l := list; l != nil; l = l.next {
+		encodings = append(encodings, wrapSourceEncoding((*C.GtkSourceEncoding)(unsafe.Pointer(l.data))))
+	}
+	C.g_slist_free(list)
+	return encodings
+}
+
+// SourceEncodingGetFromCharset is a wrapper around gtk_source_encoding_get_from_charset().
+func SourceEncodingGetFromCharset(charset string) (*SourceEncoding, error) {
+	cstr := C.CString(charset)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gtk_source_encoding_get_from_charset((*C.gchar)(cstr))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceEncoding(c), nil
+}
+
+// SourceEncodingGetUTF8 is a wrapper around gtk_source_encoding_get_utf8().
+func SourceEncodingGetUTF8() *SourceEncoding {
+	return wrapSourceEncoding(C.gtk_source_encoding_get_utf8())
+}
+
+// SourceEncodingGetCurrent is a wrapper around gtk_source_encoding_get_current().
+func SourceEncodingGetCurrent() *SourceEncoding {
+	return wrapSourceEncoding(C.gtk_source_encoding_get_current())
+}
+
+// SourceEncodingGetAll is a wrapper around gtk_source_encoding_get_all().
+func SourceEncodingGetAll() []*SourceEncoding {
+	return encodingList(C.gtk_source_encoding_get_all())
+}
+
+// SourceEncodingGetDefaultCandidates is a wrapper around gtk_source_encoding_get_default_candidates().
+func SourceEncodingGetDefaultCandidates() []*SourceEncoding {
+	return encodingList(C.gtk_source_encoding_get_default_candidates())
+}
+
+// GetCharset is a wrapper around gtk_source_encoding_get_charset().
+func (v *SourceEncoding) GetCharset() string {
+	return goString(C.gtk_source_encoding_get_charset(v.native()))
+}
+
+// GetName is a wrapper around gtk_source_encoding_get_name().
+func (v *SourceEncoding) GetName() string {
+	return goString(C.gtk_source_encoding_get_name(v.native()))
+}
+
+// String is a wrapper around gtk_source_encoding_to_string().
+func (v *SourceEncoding) String() string {
+	c := C.gtk_source_encoding_to_string(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
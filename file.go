@@ -0,0 +1,132 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "file.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_file_get_type()), marshalSourceFile},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceFile"] = wrapSourceFile
+}
+
+// SourceNewlineType is a representation of GtkSourceNewlineType.
+type SourceNewlineType int
+
+const (
+	SOURCE_NEWLINE_TYPE_LF    SourceNewlineType = C.GTK_SOURCE_NEWLINE_TYPE_LF
+	SOURCE_NEWLINE_TYPE_CR    SourceNewlineType = C.GTK_SOURCE_NEWLINE_TYPE_CR
+	SOURCE_NEWLINE_TYPE_CR_LF SourceNewlineType = C.GTK_SOURCE_NEWLINE_TYPE_CR_LF
+)
+
+// SourceCompressionType is a representation of GtkSourceCompressionType.
+type SourceCompressionType int
+
+const (
+	SOURCE_COMPRESSION_TYPE_NONE SourceCompressionType = C.GTK_SOURCE_COMPRESSION_TYPE_NONE
+	SOURCE_COMPRESSION_TYPE_GZIP SourceCompressionType = C.GTK_SOURCE_COMPRESSION_TYPE_GZIP
+)
+
+/*
+ * GtkSourceFile
+ */
+
+// SourceFile is a representation of GtkSourceFile.
+type SourceFile struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceFile.
+func (v *SourceFile) native() *C.GtkSourceFile {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceFile(p)
+}
+
+func marshalSourceFile(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceFile(obj), nil
+}
+
+func wrapSourceFile(obj *glib.Object) *SourceFile {
+	return &SourceFile{obj}
+}
+
+// SourceFileNew is a wrapper around gtk_source_file_new().
+func SourceFileNew() (*SourceFile, error) {
+	c := C.gtk_source_file_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceFile(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SetLocation is a wrapper around gtk_source_file_set_location().
+func (v *SourceFile) SetLocation(location *glib.File) {
+	var c *C.GFile
+	if location != nil {
+		c = C.toGFile(unsafe.Pointer(location.GObject))
+	}
+	C.gtk_source_file_set_location(v.native(), c)
+}
+
+// GetLocation is a wrapper around gtk_source_file_get_location().
+func (v *SourceFile) GetLocation() *glib.File {
+	c := C.gtk_source_file_get_location(v.native())
+	if c == nil {
+		return nil
+	}
+	return &glib.File{glib.Take(unsafe.Pointer(c))}
+}
+
+// GetEncoding is a wrapper around gtk_source_file_get_encoding().
+func (v *SourceFile) GetEncoding() *SourceEncoding {
+	return wrapSourceEncoding(C.gtk_source_file_get_encoding(v.native()))
+}
+
+// GetNewlineType is a wrapper around gtk_source_file_get_newline_type().
+func (v *SourceFile) GetNewlineType() SourceNewlineType {
+	return SourceNewlineType(C.gtk_source_file_get_newline_type(v.native()))
+}
+
+// GetCompressionType is a wrapper around gtk_source_file_get_compression_type().
+func (v *SourceFile) GetCompressionType() SourceCompressionType {
+	return SourceCompressionType(C.gtk_source_file_get_compression_type(v.native()))
+}
+
+// CheckFileOnDisk is a wrapper around gtk_source_file_check_file_on_disk().
+func (v *SourceFile) CheckFileOnDisk() {
+	C.gtk_source_file_check_file_on_disk(v.native())
+}
+
+// IsLocal is a wrapper around gtk_source_file_is_local().
+func (v *SourceFile) IsLocal() bool {
+	return gobool(C.gtk_source_file_is_local(v.native()))
+}
+
+// IsExternallyModified is a wrapper around gtk_source_file_is_externally_modified().
+func (v *SourceFile) IsExternallyModified() bool {
+	return gobool(C.gtk_source_file_is_externally_modified(v.native()))
+}
+
+// IsDeleted is a wrapper around gtk_source_file_is_deleted().
+func (v *SourceFile) IsDeleted() bool {
+	return gobool(C.gtk_source_file_is_deleted(v.native()))
+}
+
+// IsReadonly is a wrapper around gtk_source_file_is_readonly().
+func (v *SourceFile) IsReadonly() bool {
+	return gobool(C.gtk_source_file_is_readonly(v.native()))
+}
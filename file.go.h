@@ -0,0 +1,13 @@
+#include <gtk/gtk.h>
+
+static GtkSourceFile *
+toGtkSourceFile(void *p)
+{
+	return (GTK_SOURCE_FILE(p));
+}
+
+static GFile *
+toGFile(void *p)
+{
+	return (G_FILE(p));
+}
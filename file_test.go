@@ -0,0 +1,232 @@
+package sourceview
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// newTestFile returns a SourceFile located at path.
+func newTestFile(t *testing.T, path string) *SourceFile {
+	t.Helper()
+	file, err := SourceFileNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.SetLocation(glib.FileNew(path))
+	return file
+}
+
+// tempDir returns a temporary directory removed at the end of the test.
+func tempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "sourceview-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestSourceEncoding(t *testing.T) {
+	requireGTK(t)
+
+	utf8 := SourceEncodingGetUTF8()
+	if got := utf8.GetCharset(); got != "UTF-8" {
+		t.Errorf("GetCharset() = %q, want %q", got, "UTF-8")
+	}
+	if utf8.GetName() == "" || utf8.String() == "" {
+		t.Errorf("GetName(), String() = %q, %q, want non-empty names", utf8.GetName(), utf8.String())
+	}
+
+	latin, err := SourceEncodingGetFromCharset("ISO-8859-15")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := latin.GetCharset(); got != "ISO-8859-15" {
+		t.Errorf("GetCharset() = %q, want %q", got, "ISO-8859-15")
+	}
+	if _, err := SourceEncodingGetFromCharset("no-such-charset"); err == nil {
+		t.Error("SourceEncodingGetFromCharset() found an unknown charset")
+	}
+
+	var found bool
+	for _, e := range SourceEncodingGetAll() {
+		if e.GetCharset() == "ISO-8859-15" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("SourceEncodingGetAll() does not list ISO-8859-15")
+	}
+	if len(SourceEncodingGetDefaultCandidates()) == 0 {
+		t.Error("SourceEncodingGetDefaultCandidates() returned no encoding")
+	}
+}
+
+func TestSourceFileLoaderLoadAsync(t *testing.T) {
+	requireGTK(t)
+
+	path := filepath.Join(tempDir(t), "load.txt")
+	if err := ioutil.WriteFile(path, []byte("first line\nsecond line\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file := newTestFile(t, path)
+	if got := file.GetLocation().GetPath(); got != path {
+		t.Errorf("GetLocation() = %q, want %q", got, path)
+	}
+	if !file.IsLocal() {
+		t.Error("IsLocal() = false for a file in the temporary directory")
+	}
+
+	buffer := newTestBuffer(t, "")
+	loader, err := SourceFileLoaderNew(buffer, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loader.SetCandidateEncodings([]*SourceEncoding{SourceEncodingGetUTF8()})
+	if got, err := loader.GetBuffer(); err != nil || got.Native() != buffer.Native() {
+		t.Errorf("GetBuffer() = %v, %v, want the loaded buffer", got, err)
+	}
+	if got, err := loader.GetFile(); err != nil || got.Native() != file.Native() {
+		t.Errorf("GetFile() = %v, %v, want the loaded file", got, err)
+	}
+	if got := loader.GetLocation().GetPath(); got != path {
+		t.Errorf("GetLocation() = %q, want %q", got, path)
+	}
+
+	var done bool
+	loader.LoadAsync(context.Background(), nil, func(err error) {
+		done = true
+		if err != nil {
+			t.Error(err)
+		}
+	})
+	runMainLoopUntil(t, func() bool { return done })
+
+	// The buffer has an implicit trailing newline, so the last one of the
+	// file is not part of the text.
+	if got, want := bufferText(t, buffer), "first line\nsecond line"; got != want {
+		t.Errorf("loaded text = %q, want %q", got, want)
+	}
+	if got := loader.GetEncoding().GetCharset(); got != "UTF-8" {
+		t.Errorf("GetEncoding() = %q, want %q", got, "UTF-8")
+	}
+	if got := loader.GetNewlineType(); got != SOURCE_NEWLINE_TYPE_LF {
+		t.Errorf("GetNewlineType() = %v, want SOURCE_NEWLINE_TYPE_LF", got)
+	}
+	if got := loader.GetCompressionType(); got != SOURCE_COMPRESSION_TYPE_NONE {
+		t.Errorf("GetCompressionType() = %v, want SOURCE_COMPRESSION_TYPE_NONE", got)
+	}
+	if got := file.GetEncoding().GetCharset(); got != "UTF-8" {
+		t.Errorf("file GetEncoding() = %q, want %q", got, "UTF-8")
+	}
+
+	file.CheckFileOnDisk()
+	if file.IsDeleted() || file.IsExternallyModified() {
+		t.Errorf("IsDeleted(), IsExternallyModified() = %v, %v right after loading",
+			file.IsDeleted(), file.IsExternallyModified())
+	}
+}
+
+func TestSourceFileLoaderMissingFile(t *testing.T) {
+	requireGTK(t)
+
+	file := newTestFile(t, filepath.Join(tempDir(t), "missing.txt"))
+	loader, err := SourceFileLoaderNew(newTestBuffer(t, ""), file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var done bool
+	loader.LoadAsync(context.Background(), nil, func(err error) {
+		done = true
+		if err == nil {
+			t.Error("LoadAsync() of a missing file succeeded")
+		}
+	})
+	runMainLoopUntil(t, func() bool { return done })
+}
+
+func TestSourceFileSaverSaveAsync(t *testing.T) {
+	requireGTK(t)
+
+	path := filepath.Join(tempDir(t), "save.txt")
+	file := newTestFile(t, path)
+	buffer := newTestBuffer(t, "saved\ntext")
+	saver, err := SourceFileSaverNew(buffer, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saver.SetNewlineType(SOURCE_NEWLINE_TYPE_CR_LF)
+	saver.SetEncoding(SourceEncodingGetUTF8())
+	saver.SetCompressionType(SOURCE_COMPRESSION_TYPE_NONE)
+	saver.SetFlags(SOURCE_FILE_SAVER_FLAGS_IGNORE_MODIFICATION_TIME)
+	if got := saver.GetNewlineType(); got != SOURCE_NEWLINE_TYPE_CR_LF {
+		t.Errorf("GetNewlineType() = %v, want SOURCE_NEWLINE_TYPE_CR_LF", got)
+	}
+	if got := saver.GetEncoding().GetCharset(); got != "UTF-8" {
+		t.Errorf("GetEncoding() = %q, want %q", got, "UTF-8")
+	}
+	if got := saver.GetCompressionType(); got != SOURCE_COMPRESSION_TYPE_NONE {
+		t.Errorf("GetCompressionType() = %v, want SOURCE_COMPRESSION_TYPE_NONE", got)
+	}
+	if got := saver.GetFlags(); got != SOURCE_FILE_SAVER_FLAGS_IGNORE_MODIFICATION_TIME {
+		t.Errorf("GetFlags() = %v, want SOURCE_FILE_SAVER_FLAGS_IGNORE_MODIFICATION_TIME", got)
+	}
+	if got := saver.GetLocation().GetPath(); got != path {
+		t.Errorf("GetLocation() = %q, want %q", got, path)
+	}
+
+	var done bool
+	saver.SaveAsync(context.Background(), nil, func(err error) {
+		done = true
+		if err != nil {
+			t.Error(err)
+		}
+	})
+	runMainLoopUntil(t, func() bool { return done })
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "saved\r\ntext\r\n"; got != want {
+		t.Errorf("saved file = %q, want %q", got, want)
+	}
+	if got := file.GetNewlineType(); got != SOURCE_NEWLINE_TYPE_CR_LF {
+		t.Errorf("file GetNewlineType() = %v after saving, want SOURCE_NEWLINE_TYPE_CR_LF", got)
+	}
+}
+
+func TestSourceFileSaverNewWithTarget(t *testing.T) {
+	requireGTK(t)
+
+	dir := tempDir(t)
+	file := newTestFile(t, filepath.Join(dir, "original.txt"))
+	target := filepath.Join(dir, "copy.txt")
+	saver, err := SourceFileSaverNewWithTarget(newTestBuffer(t, "copy"), file, glib.FileNew(target))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var done bool
+	saver.SaveAsync(context.Background(), nil, func(err error) {
+		done = true
+		if err != nil {
+			t.Error(err)
+		}
+	})
+	runMainLoopUntil(t, func() bool { return done })
+
+	if data, err := ioutil.ReadFile(target); err != nil || string(data) != "copy\n" {
+		t.Errorf("saved target = %q, %v, want %q", data, err, "copy\n")
+	}
+	if got := file.GetLocation().GetPath(); got != target {
+		t.Errorf("file location = %q after saving, want the target %q", got, target)
+	}
+}
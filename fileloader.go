@@ -0,0 +1,173 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "fileloader.go.h"
+import "C"
+import (
+	"context"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_file_loader_get_type()), marshalSourceFileLoader},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceFileLoader"] = wrapSourceFileLoader
+}
+
+// SourceFileProgressCallback is called while a file is loaded or saved with
+// the number of bytes processed so far and the total number of bytes.
+type SourceFileProgressCallback func(current, total int64)
+
+// fileOperation holds the Go state of a running SourceFileLoader.LoadAsync
+// or SourceFileSaver.SaveAsync call.
+type fileOperation struct {
+	ctx         context.Context
+	done        chan struct{}
+	cancellable *C.GCancellable
+	progress    SourceFileProgressCallback
+	callback    func(error)
+}
+
+// startFileOperation registers op and returns its id together with a
+// GCancellable that is cancelled when ctx is done. The operation owns the
+// cancellable until finishFileOperation is called; the goroutine watching ctx
+// holds a reference of its own, so cancelling never races with the release.
+func startFileOperation(op *fileOperation) (C.guintptr, *C.GCancellable) {
+	op.done = make(chan struct{})
+	op.cancellable = C.g_cancellable_new()
+	c := op.cancellable
+	C.g_object_ref(C.gpointer(c))
+	go func() {
+		select {
+		case <-op.ctx.Done():
+			C.g_cancellable_cancel(c)
+		case <-op.done:
+		}
+		C.g_object_unref(C.gpointer(c))
+	}()
+	return C.guintptr(assignCallback(op)), op.cancellable
+}
+
+// finishFileOperation unregisters the operation with the given id, converts
+// err with convert and calls the callback. Only G_IO_ERROR_CANCELLED is
+// reported as ctx.Err(); other errors are passed on even if ctx is done.
+func finishFileOperation(id uintptr, err *C.GError, convert func(*C.GError) error) {
+	op := getAndDeleteCallback(id).(*fileOperation)
+	close(op.done)
+	C.g_object_unref(C.gpointer(op.cancellable))
+	if err != nil && op.ctx.Err() != nil &&
+		gobool(C.g_error_matches(err, C.g_io_error_quark(), C.G_IO_ERROR_CANCELLED)) {
+		C.g_error_free(err)
+		op.callback(op.ctx.Err())
+		return
+	}
+	op.callback(convert(err))
+}
+
+/*
+ * GtkSourceFileLoader
+ */
+
+// SourceFileLoader is a representation of GtkSourceFileLoader.
+type SourceFileLoader struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceFileLoader.
+func (v *SourceFileLoader) native() *C.GtkSourceFileLoader {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceFileLoader(p)
+}
+
+func marshalSourceFileLoader(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceFileLoader(obj), nil
+}
+
+func wrapSourceFileLoader(obj *glib.Object) *SourceFileLoader {
+	return &SourceFileLoader{obj}
+}
+
+// SourceFileLoaderNew is a wrapper around gtk_source_file_loader_new().
+func SourceFileLoaderNew(buffer *SourceBuffer, file *SourceFile) (*SourceFileLoader, error) {
+	c := C.gtk_source_file_loader_new(buffer.native(), file.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceFileLoader(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetBuffer is a wrapper around gtk_source_file_loader_get_buffer().
+func (v *SourceFileLoader) GetBuffer() (*SourceBuffer, error) {
+	c := C.gtk_source_file_loader_get_buffer(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceBuffer(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetFile is a wrapper around gtk_source_file_loader_get_file().
+func (v *SourceFileLoader) GetFile() (*SourceFile, error) {
+	c := C.gtk_source_file_loader_get_file(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceFile(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetLocation is a wrapper around gtk_source_file_loader_get_location().
+func (v *SourceFileLoader) GetLocation() *glib.File {
+	c := C.gtk_source_file_loader_get_location(v.native())
+	if c == nil {
+		return nil
+	}
+	return &glib.File{glib.Take(unsafe.Pointer(c))}
+}
+
+// SetCandidateEncodings is a wrapper around gtk_source_file_loader_set_candidate_encodings().
+func (v *SourceFileLoader) SetCandidateEncodings(encodings []*SourceEncoding) {
+	var list *C.GSList
+	for _, encoding := range encodings {
+		list = C.g_slist_append(list, C.gpointer(encoding.native()))
+	}
+	defer C.g_slist_free(list)
+	C.gtk_source_file_loader_set_candidate_encodings(v.native(), list)
+}
+
+// GetEncoding is a wrapper around gtk_source_file_loader_get_encoding().
+// It is only meaningful once loading has finished.
+func (v *SourceFileLoader) GetEncoding() *SourceEncoding {
+	return wrapSourceEncoding(C.gtk_source_file_loader_get_encoding(v.native()))
+}
+
+// GetNewlineType is a wrapper around gtk_source_file_loader_get_newline_type().
+func (v *SourceFileLoader) GetNewlineType() SourceNewlineType {
+	return SourceNewlineType(C.gtk_source_file_loader_get_newline_type(v.native()))
+}
+
+// GetCompressionType is a wrapper around gtk_source_file_loader_get_compression_type().
+func (v *SourceFileLoader) GetCompressionType() SourceCompressionType {
+	return SourceCompressionType(C.gtk_source_file_loader_get_compression_type(v.native()))
+}
+
+// LoadAsync is a wrapper around gtk_source_file_loader_load_async(). The load
+// is cancelled when ctx is done. progress may be nil. callback is invoked
+// from the main loop with nil on success; after it returns, the detected
+// encoding, newline type and compression are available from the loader and
+// its SourceFile. If the load is cancelled through ctx, callback receives
+// ctx.Err().
+func (v *SourceFileLoader) LoadAsync(ctx context.Context, progress SourceFileProgressCallback, callback func(err error)) {
+	op := &fileOperation{ctx: ctx, progress: progress, callback: callback}
+	id, c := startFileOperation(op)
+	C.file_loader_load_async(v.native(), c, gbool(progress != nil), id)
+}
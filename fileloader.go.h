@@ -0,0 +1,19 @@
+#include <gtk/gtk.h>
+
+static GtkSourceFileLoader *
+toGtkSourceFileLoader(void *p)
+{
+	return (GTK_SOURCE_FILE_LOADER(p));
+}
+
+extern void goFileProgress(goffset current_num_bytes, goffset total_num_bytes, gpointer user_data);
+extern void goFileLoaderLoadReady(GObject *source, GAsyncResult *result, gpointer user_data);
+
+static void
+file_loader_load_async(GtkSourceFileLoader *loader, GCancellable *cancellable,
+                       gboolean progress, guintptr id)
+{
+	gtk_source_file_loader_load_async(loader, G_PRIORITY_DEFAULT, cancellable,
+		progress ? goFileProgress : NULL, (gpointer)id, NULL,
+		goFileLoaderLoadReady, (gpointer)id);
+}
@@ -0,0 +1,18 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+import "unsafe"
+
+//export goFileProgress
+func goFileProgress(current, total C.goffset, data C.gpointer) {
+	op := getCallback(uintptr(data)).(*fileOperation)
+	op.progress(int64(current), int64(total))
+}
+
+//export goFileLoaderLoadReady
+func goFileLoaderLoadReady(source *C.GObject, result *C.GAsyncResult, data C.gpointer) {
+	var err *C.GError
+	C.gtk_source_file_loader_load_finish((*C.GtkSourceFileLoader)(unsafe.Pointer(source)), result, &err)
+	finishFileOperation(uintptr(data), err, goError)
+}
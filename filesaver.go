@@ -0,0 +1,192 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "file.go.h"
+// #include "filesaver.go.h"
+import "C"
+import (
+	"context"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_file_saver_get_type()), marshalSourceFileSaver},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceFileSaver"] = wrapSourceFileSaver
+}
+
+// SourceFileSaverFlags is a representation of GtkSourceFileSaverFlags.
+type SourceFileSaverFlags int
+
+const (
+	SOURCE_FILE_SAVER_FLAGS_NONE                     SourceFileSaverFlags = C.GTK_SOURCE_FILE_SAVER_FLAGS_NONE
+	SOURCE_FILE_SAVER_FLAGS_IGNORE_INVALID_CHARS     SourceFileSaverFlags = C.GTK_SOURCE_FILE_SAVER_FLAGS_IGNORE_INVALID_CHARS
+	SOURCE_FILE_SAVER_FLAGS_IGNORE_MODIFICATION_TIME SourceFileSaverFlags = C.GTK_SOURCE_FILE_SAVER_FLAGS_IGNORE_MODIFICATION_TIME
+	SOURCE_FILE_SAVER_FLAGS_CREATE_BACKUP            SourceFileSaverFlags = C.GTK_SOURCE_FILE_SAVER_FLAGS_CREATE_BACKUP
+)
+
+// SourceFileSaverErrorCode is a representation of GtkSourceFileSaverError.
+type SourceFileSaverErrorCode int
+
+const (
+	SOURCE_FILE_SAVER_ERROR_INVALID_CHARS       SourceFileSaverErrorCode = C.GTK_SOURCE_FILE_SAVER_ERROR_INVALID_CHARS
+	SOURCE_FILE_SAVER_ERROR_EXTERNALLY_MODIFIED SourceFileSaverErrorCode = C.GTK_SOURCE_FILE_SAVER_ERROR_EXTERNALLY_MODIFIED
+)
+
+// SourceFileSaverError is the error passed to the SaveAsync callback for
+// errors in the GTK_SOURCE_FILE_SAVER_ERROR domain. Use errors.As to tell
+// them apart from I/O errors, for example to ask the user whether a file that
+// was modified on disk should be overwritten.
+type SourceFileSaverError struct {
+	Code    SourceFileSaverErrorCode
+	Message string
+}
+
+func (e *SourceFileSaverError) Error() string {
+	return e.Message
+}
+
+// fileSaverError converts a GError to a Go error and frees it.
+func fileSaverError(err *C.GError) error {
+	if err == nil || err.domain != C.gtk_source_file_saver_error_quark() {
+		return goError(err)
+	}
+	defer C.g_error_free(err)
+	return &SourceFileSaverError{
+		Code:    SourceFileSaverErrorCode(err.code),
+		Message: goString(err.message),
+	}
+}
+
+/*
+ * GtkSourceFileSaver
+ */
+
+// SourceFileSaver is a representation of GtkSourceFileSaver.
+type SourceFileSaver struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceFileSaver.
+func (v *SourceFileSaver) native() *C.GtkSourceFileSaver {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceFileSaver(p)
+}
+
+func marshalSourceFileSaver(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceFileSaver(obj), nil
+}
+
+func wrapSourceFileSaver(obj *glib.Object) *SourceFileSaver {
+	return &SourceFileSaver{obj}
+}
+
+// SourceFileSaverNew is a wrapper around gtk_source_file_saver_new().
+func SourceFileSaverNew(buffer *SourceBuffer, file *SourceFile) (*SourceFileSaver, error) {
+	c := C.gtk_source_file_saver_new(buffer.native(), file.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceFileSaver(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SourceFileSaverNewWithTarget is a wrapper around gtk_source_file_saver_new_with_target().
+func SourceFileSaverNewWithTarget(buffer *SourceBuffer, file *SourceFile, target *glib.File) (*SourceFileSaver, error) {
+	c := C.gtk_source_file_saver_new_with_target(buffer.native(), file.native(),
+		C.toGFile(unsafe.Pointer(target.GObject)))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceFileSaver(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetBuffer is a wrapper around gtk_source_file_saver_get_buffer().
+func (v *SourceFileSaver) GetBuffer() (*SourceBuffer, error) {
+	c := C.gtk_source_file_saver_get_buffer(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceBuffer(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetFile is a wrapper around gtk_source_file_saver_get_file().
+func (v *SourceFileSaver) GetFile() (*SourceFile, error) {
+	c := C.gtk_source_file_saver_get_file(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceFile(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetLocation is a wrapper around gtk_source_file_saver_get_location().
+func (v *SourceFileSaver) GetLocation() *glib.File {
+	c := C.gtk_source_file_saver_get_location(v.native())
+	if c == nil {
+		return nil
+	}
+	return &glib.File{glib.Take(unsafe.Pointer(c))}
+}
+
+// SetEncoding is a wrapper around gtk_source_file_saver_set_encoding().
+// A nil encoding means UTF-8.
+func (v *SourceFileSaver) SetEncoding(encoding *SourceEncoding) {
+	C.gtk_source_file_saver_set_encoding(v.native(), encoding.native())
+}
+
+// GetEncoding is a wrapper around gtk_source_file_saver_get_encoding().
+func (v *SourceFileSaver) GetEncoding() *SourceEncoding {
+	return wrapSourceEncoding(C.gtk_source_file_saver_get_encoding(v.native()))
+}
+
+// SetNewlineType is a wrapper around gtk_source_file_saver_set_newline_type().
+func (v *SourceFileSaver) SetNewlineType(newlineType SourceNewlineType) {
+	C.gtk_source_file_saver_set_newline_type(v.native(), C.GtkSourceNewlineType(newlineType))
+}
+
+// GetNewlineType is a wrapper around gtk_source_file_saver_get_newline_type().
+func (v *SourceFileSaver) GetNewlineType() SourceNewlineType {
+	return SourceNewlineType(C.gtk_source_file_saver_get_newline_type(v.native()))
+}
+
+// SetCompressionType is a wrapper around gtk_source_file_saver_set_compression_type().
+func (v *SourceFileSaver) SetCompressionType(compressionType SourceCompressionType) {
+	C.gtk_source_file_saver_set_compression_type(v.native(), C.GtkSourceCompressionType(compressionType))
+}
+
+// GetCompressionType is a wrapper around gtk_source_file_saver_get_compression_type().
+func (v *SourceFileSaver) GetCompressionType() SourceCompressionType {
+	return SourceCompressionType(C.gtk_source_file_saver_get_compression_type(v.native()))
+}
+
+// SetFlags is a wrapper around gtk_source_file_saver_set_flags().
+func (v *SourceFileSaver) SetFlags(flags SourceFileSaverFlags) {
+	C.gtk_source_file_saver_set_flags(v.native(), C.GtkSourceFileSaverFlags(flags))
+}
+
+// GetFlags is a wrapper around gtk_source_file_saver_get_flags().
+func (v *SourceFileSaver) GetFlags() SourceFileSaverFlags {
+	return SourceFileSaverFlags(C.gtk_source_file_saver_get_flags(v.native()))
+}
+
+// SaveAsync is a wrapper around gtk_source_file_saver_save_async(). The save
+// is cancelled when ctx is done. progress may be nil. callback is invoked
+// from the main loop with nil on success, or with a *SourceFileSaverError if
+// the file was modified externally or contains invalid characters. If the
+// save is cancelled through ctx, callback receives ctx.Err(); errors that
+// occur before cancellation takes effect are still reported as they are.
+func (v *SourceFileSaver) SaveAsync(ctx context.Context, progress SourceFileProgressCallback, callback func(err error)) {
+	op := &fileOperation{ctx: ctx, progress: progress, callback: callback}
+	id, c := startFileOperation(op)
+	C.file_saver_save_async(v.native(), c, gbool(progress != nil), id)
+}
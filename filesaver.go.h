@@ -0,0 +1,19 @@
+#include <gtk/gtk.h>
+
+static GtkSourceFileSaver *
+toGtkSourceFileSaver(void *p)
+{
+	return (GTK_SOURCE_FILE_SAVER(p));
+}
+
+extern void goFileProgress(goffset current_num_bytes, goffset total_num_bytes, gpointer user_data);
+extern void goFileSaverSaveReady(GObject *source, GAsyncResult *result, gpointer user_data);
+
+static void
+file_saver_save_async(GtkSourceFileSaver *saver, GCancellable *cancellable,
+                      gboolean progress, guintptr id)
+{
+	gtk_source_file_saver_save_async(saver, G_PRIORITY_DEFAULT, cancellable,
+		progress ? goFileProgress : NULL, (gpointer)id, NULL,
+		goFileSaverSaveReady, (gpointer)id);
+}
@@ -0,0 +1,12 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+import "unsafe"
+
+//export goFileSaverSaveReady
+func goFileSaverSaveReady(source *C.GObject, result *C.GAsyncResult, data C.gpointer) {
+	var err *C.GError
+	C.gtk_source_file_saver_save_finish((*C.GtkSourceFileSaver)(unsafe.Pointer(source)), result, &err)
+	finishFileOperation(uintptr(data), err, fileSaverError)
+}
@@ -0,0 +1,60 @@
+//go:build !gtksourceview4
+// +build !gtksourceview4
+
+package sourceview
+
+// The package is built against GtkSourceView 3 unless the gtksourceview4
+// build tag is set. The functions below call the GtkSourceView 3 names of
+// functions that GtkSourceView 4 renamed, and handle the signals whose
+// arguments changed; gtksourceview4.go has the other half.
+
+// #cgo pkg-config: gtksourceview-3.0
+// #include <gtksourceview/gtksource.h>
+//
+// static void
+// source_view_emit_move_lines(GtkSourceView *view, gint count)
+// {
+// 	g_signal_emit_by_name(view, "move-lines", FALSE, count);
+// }
+import "C"
+import "github.com/gotk3/gotk3/glib"
+
+//go:generate go run ./internal/girgen -gir /usr/share/gir-1.0/GtkSource-3.0.gir -build !gtksourceview4
+
+func completionItemNew() *C.GtkSourceCompletionItem {
+	return C.gtk_source_completion_item_new2()
+}
+
+func searchForward(search *C.GtkSourceSearchContext, iter, start, end *C.GtkTextIter, wrapped *C.gboolean) C.gboolean {
+	return C.gtk_source_search_context_forward2(search, iter, start, end, wrapped)
+}
+
+func searchBackward(search *C.GtkSourceSearchContext, iter, start, end *C.GtkTextIter, wrapped *C.gboolean) C.gboolean {
+	return C.gtk_source_search_context_backward2(search, iter, start, end, wrapped)
+}
+
+func searchForwardFinish(search *C.GtkSourceSearchContext, result *C.GAsyncResult, start, end *C.GtkTextIter, wrapped *C.gboolean, err **C.GError) C.gboolean {
+	return C.gtk_source_search_context_forward_finish2(search, result, start, end, wrapped, err)
+}
+
+func searchBackwardFinish(search *C.GtkSourceSearchContext, result *C.GAsyncResult, start, end *C.GtkTextIter, wrapped *C.gboolean, err **C.GError) C.gboolean {
+	return C.gtk_source_search_context_backward_finish2(search, result, start, end, wrapped, err)
+}
+
+func searchReplace(search *C.GtkSourceSearchContext, start, end *C.GtkTextIter, replace *C.gchar, err **C.GError) C.gboolean {
+	return C.gtk_source_search_context_replace2(search, start, end, replace, -1, err)
+}
+
+// The "move-lines" signal of GtkSourceView 3 has a deprecated copy argument
+// before count. GtkSourceView 4 dropped copy and replaced count with a down
+// flag.
+
+func connectMoveLines(v *SourceView, f func(count int)) glib.SignalHandle {
+	return v.Connect("move-lines", func(_ interface{}, _ bool, count int) {
+		f(count)
+	})
+}
+
+func emitMoveLines(view *C.GtkSourceView, count int) {
+	C.source_view_emit_move_lines(view, C.gint(count))
+}
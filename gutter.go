@@ -0,0 +1,400 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "gutter.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_gutter_renderer_get_type()), marshalSourceGutterRenderer},
+		{glib.Type(C.gtk_source_gutter_renderer_pixbuf_get_type()), marshalSourceGutterRendererPixbuf},
+		{glib.Type(C.gtk_source_gutter_renderer_text_get_type()), marshalSourceGutterRendererText},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceGutterRenderer"] = wrapSourceGutterRenderer
+	gtk.WrapMap["GtkSourceGutterRendererPixbuf"] = wrapSourceGutterRendererPixbuf
+	gtk.WrapMap["GtkSourceGutterRendererText"] = wrapSourceGutterRendererText
+}
+
+// SourceGutterRendererState is a representation of GtkSourceGutterRendererState.
+type SourceGutterRendererState int
+
+const (
+	SOURCE_GUTTER_RENDERER_STATE_NORMAL   SourceGutterRendererState = C.GTK_SOURCE_GUTTER_RENDERER_STATE_NORMAL
+	SOURCE_GUTTER_RENDERER_STATE_CURSOR   SourceGutterRendererState = C.GTK_SOURCE_GUTTER_RENDERER_STATE_CURSOR
+	SOURCE_GUTTER_RENDERER_STATE_PRELIT   SourceGutterRendererState = C.GTK_SOURCE_GUTTER_RENDERER_STATE_PRELIT
+	SOURCE_GUTTER_RENDERER_STATE_SELECTED SourceGutterRendererState = C.GTK_SOURCE_GUTTER_RENDERER_STATE_SELECTED
+)
+
+// SourceGutterRendererAlignmentMode is a representation of GtkSourceGutterRendererAlignmentMode.
+type SourceGutterRendererAlignmentMode int
+
+const (
+	SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_CELL  SourceGutterRendererAlignmentMode = C.GTK_SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_CELL
+	SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_FIRST SourceGutterRendererAlignmentMode = C.GTK_SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_FIRST
+	SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_LAST  SourceGutterRendererAlignmentMode = C.GTK_SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_LAST
+)
+
+/*
+ * GtkSourceGutter
+ */
+
+// Insert is a wrapper around gtk_source_gutter_insert().
+func (v *SourceGutter) Insert(renderer ISourceGutterRenderer, position int) bool {
+	c := C.gtk_source_gutter_insert(v.native(), renderer.toSourceGutterRenderer(), C.gint(position))
+	return gobool(c)
+}
+
+// Reorder is a wrapper around gtk_source_gutter_reorder().
+func (v *SourceGutter) Reorder(renderer ISourceGutterRenderer, position int) {
+	C.gtk_source_gutter_reorder(v.native(), renderer.toSourceGutterRenderer(), C.gint(position))
+}
+
+// Remove is a wrapper around gtk_source_gutter_remove().
+func (v *SourceGutter) Remove(renderer ISourceGutterRenderer) {
+	C.gtk_source_gutter_remove(v.native(), renderer.toSourceGutterRenderer())
+}
+
+// GetRendererAtPos is a wrapper around gtk_source_gutter_get_renderer_at_pos().
+func (v *SourceGutter) GetRendererAtPos(x, y int) *SourceGutterRenderer {
+	c := C.gtk_source_gutter_get_renderer_at_pos(v.native(), C.gint(x), C.gint(y))
+	if c == nil {
+		return nil
+	}
+	return wrapSourceGutterRenderer(glib.Take(unsafe.Pointer(c)))
+}
+
+// QueueDraw is a wrapper around gtk_source_gutter_queue_draw().
+func (v *SourceGutter) QueueDraw() {
+	C.gtk_source_gutter_queue_draw(v.native())
+}
+
+/*
+ * GtkSourceGutterRenderer
+ */
+
+// ISourceGutterRenderer is an interface type implemented by all structs
+// embedding a SourceGutterRenderer.  It is meant to be used as an argument
+// type for wrapper functions that wrap around a C GTK function taking a
+// GtkSourceGutterRenderer.
+type ISourceGutterRenderer interface {
+	toSourceGutterRenderer() *C.GtkSourceGutterRenderer
+}
+
+// SourceGutterRenderer is a representation of GtkSourceGutterRenderer.
+type SourceGutterRenderer struct {
+	glib.InitiallyUnowned
+}
+
+// native returns a pointer to the underlying GtkSourceGutterRenderer.
+func (v *SourceGutterRenderer) native() *C.GtkSourceGutterRenderer {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceGutterRenderer(p)
+}
+
+func (v *SourceGutterRenderer) toSourceGutterRenderer() *C.GtkSourceGutterRenderer {
+	if v == nil {
+		return nil
+	}
+	return v.native()
+}
+
+func marshalSourceGutterRenderer(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceGutterRenderer(obj), nil
+}
+
+func wrapSourceGutterRenderer(obj *glib.Object) *SourceGutterRenderer {
+	return &SourceGutterRenderer{glib.InitiallyUnowned{obj}}
+}
+
+// SetSize is a wrapper around gtk_source_gutter_renderer_set_size().
+func (v *SourceGutterRenderer) SetSize(size int) {
+	C.gtk_source_gutter_renderer_set_size(v.native(), C.gint(size))
+}
+
+// GetSize is a wrapper around gtk_source_gutter_renderer_get_size().
+func (v *SourceGutterRenderer) GetSize() int {
+	return int(C.gtk_source_gutter_renderer_get_size(v.native()))
+}
+
+// SetVisible is a wrapper around gtk_source_gutter_renderer_set_visible().
+func (v *SourceGutterRenderer) SetVisible(visible bool) {
+	C.gtk_source_gutter_renderer_set_visible(v.native(), gbool(visible))
+}
+
+// GetVisible is a wrapper around gtk_source_gutter_renderer_get_visible().
+func (v *SourceGutterRenderer) GetVisible() bool {
+	return gobool(C.gtk_source_gutter_renderer_get_visible(v.native()))
+}
+
+// SetPadding is a wrapper around gtk_source_gutter_renderer_set_padding().
+func (v *SourceGutterRenderer) SetPadding(xpad, ypad int) {
+	C.gtk_source_gutter_renderer_set_padding(v.native(), C.gint(xpad), C.gint(ypad))
+}
+
+// GetPadding is a wrapper around gtk_source_gutter_renderer_get_padding().
+func (v *SourceGutterRenderer) GetPadding() (xpad, ypad int) {
+	var cx, cy C.gint
+	C.gtk_source_gutter_renderer_get_padding(v.native(), &cx, &cy)
+	return int(cx), int(cy)
+}
+
+// SetAlignment is a wrapper around gtk_source_gutter_renderer_set_alignment().
+func (v *SourceGutterRenderer) SetAlignment(xalign, yalign float32) {
+	C.gtk_source_gutter_renderer_set_alignment(v.native(), C.gfloat(xalign), C.gfloat(yalign))
+}
+
+// GetAlignment is a wrapper around gtk_source_gutter_renderer_get_alignment().
+func (v *SourceGutterRenderer) GetAlignment() (xalign, yalign float32) {
+	var cx, cy C.gfloat
+	C.gtk_source_gutter_renderer_get_alignment(v.native(), &cx, &cy)
+	return float32(cx), float32(cy)
+}
+
+// SetAlignmentMode is a wrapper around gtk_source_gutter_renderer_set_alignment_mode().
+func (v *SourceGutterRenderer) SetAlignmentMode(mode SourceGutterRendererAlignmentMode) {
+	C.gtk_source_gutter_renderer_set_alignment_mode(v.native(), C.GtkSourceGutterRendererAlignmentMode(mode))
+}
+
+// GetAlignmentMode is a wrapper around gtk_source_gutter_renderer_get_alignment_mode().
+func (v *SourceGutterRenderer) GetAlignmentMode() SourceGutterRendererAlignmentMode {
+	c := C.gtk_source_gutter_renderer_get_alignment_mode(v.native())
+	return SourceGutterRendererAlignmentMode(c)
+}
+
+// SetBackground is a wrapper around gtk_source_gutter_renderer_set_background().
+// A nil color unsets the background.
+func (v *SourceGutterRenderer) SetBackground(color *gdk.RGBA) {
+	var c *C.GdkRGBA
+	if color != nil {
+		c = (*C.GdkRGBA)(unsafe.Pointer(color.Native()))
+	}
+	C.gtk_source_gutter_renderer_set_background(v.native(), c)
+}
+
+// GetBackground is a wrapper around gtk_source_gutter_renderer_get_background().
+// It returns false if no background color is set.
+func (v *SourceGutterRenderer) GetBackground() (*gdk.RGBA, bool) {
+	rgba := new(C.GdkRGBA)
+	ok := C.gtk_source_gutter_renderer_get_background(v.native(), rgba)
+	return gdk.WrapRGBA(unsafe.Pointer(rgba)), gobool(ok)
+}
+
+// GetWindowType is a wrapper around gtk_source_gutter_renderer_get_window_type().
+func (v *SourceGutterRenderer) GetWindowType() gtk.TextWindowType {
+	return gtk.TextWindowType(C.gtk_source_gutter_renderer_get_window_type(v.native()))
+}
+
+// GetView is a wrapper around gtk_source_gutter_renderer_get_view().
+func (v *SourceGutterRenderer) GetView() (*SourceView, error) {
+	c := C.gtk_source_gutter_renderer_get_view(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceView(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// QueueDraw is a wrapper around gtk_source_gutter_renderer_queue_draw().
+func (v *SourceGutterRenderer) QueueDraw() {
+	C.gtk_source_gutter_renderer_queue_draw(v.native())
+}
+
+// SourceGutterRendererImpl is implemented by Go types that render a gutter
+// column, such as diff bars or coverage markers. See SourceGutterRendererNew.
+type SourceGutterRendererImpl interface {
+	// Draw draws the cell of the lines from start to end. The background
+	// set with SetBackground has already been drawn.
+	Draw(renderer *SourceGutterRenderer, cr *cairo.Context, backgroundArea, cellArea *gdk.Rectangle,
+		start, end *gtk.TextIter, state SourceGutterRendererState)
+
+	// QueryData is called before Draw to update the renderer for the
+	// lines from start to end.
+	QueryData(renderer *SourceGutterRenderer, start, end *gtk.TextIter, state SourceGutterRendererState)
+
+	// QueryActivatable reports whether the cell at iter can be activated
+	// by event.
+	QueryActivatable(renderer *SourceGutterRenderer, iter *gtk.TextIter, area *gdk.Rectangle, event *gdk.Event) bool
+
+	// Activate is called when the cell at iter is activated.
+	Activate(renderer *SourceGutterRenderer, iter *gtk.TextIter, area *gdk.Rectangle, event *gdk.Event)
+}
+
+// SourceGutterRendererNew creates a GtkSourceGutterRenderer subclass instance
+// whose virtual functions call impl. Add it to a gutter with
+// SourceGutter.Insert.
+func SourceGutterRendererNew(impl SourceGutterRendererImpl) (*SourceGutterRenderer, error) {
+	id := assignCallback(impl)
+	c := C.go_gutter_renderer_new(C.guintptr(id))
+	if c == nil {
+		deleteCallback(id)
+		return nil, errNilPtr
+	}
+	return wrapSourceGutterRenderer(glib.Take(unsafe.Pointer(c))), nil
+}
+
+/*
+ * GtkSourceGutterRendererText
+ */
+
+// SourceGutterRendererText is a representation of GtkSourceGutterRendererText.
+type SourceGutterRendererText struct {
+	SourceGutterRenderer
+}
+
+// native returns a pointer to the underlying GtkSourceGutterRendererText.
+func (v *SourceGutterRendererText) native() *C.GtkSourceGutterRendererText {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceGutterRendererText(p)
+}
+
+func marshalSourceGutterRendererText(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceGutterRendererText(obj), nil
+}
+
+func wrapSourceGutterRendererText(obj *glib.Object) *SourceGutterRendererText {
+	return &SourceGutterRendererText{SourceGutterRenderer{glib.InitiallyUnowned{obj}}}
+}
+
+// SourceGutterRendererTextNew is a wrapper around gtk_source_gutter_renderer_text_new().
+func SourceGutterRendererTextNew() (*SourceGutterRendererText, error) {
+	c := C.gtk_source_gutter_renderer_text_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceGutterRendererText(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetText is a wrapper around gtk_source_gutter_renderer_text_set_text().
+func (v *SourceGutterRendererText) SetText(text string) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_gutter_renderer_text_set_text(v.native(), (*C.gchar)(cstr), -1)
+}
+
+// SetMarkup is a wrapper around gtk_source_gutter_renderer_text_set_markup().
+func (v *SourceGutterRendererText) SetMarkup(markup string) {
+	cstr := C.CString(markup)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_gutter_renderer_text_set_markup(v.native(), (*C.gchar)(cstr), -1)
+}
+
+// Measure is a wrapper around gtk_source_gutter_renderer_text_measure().
+func (v *SourceGutterRendererText) Measure(text string) (width, height int) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var cw, ch C.gint
+	C.gtk_source_gutter_renderer_text_measure(v.native(), (*C.gchar)(cstr), &cw, &ch)
+	return int(cw), int(ch)
+}
+
+// MeasureMarkup is a wrapper around gtk_source_gutter_renderer_text_measure_markup().
+func (v *SourceGutterRendererText) MeasureMarkup(markup string) (width, height int) {
+	cstr := C.CString(markup)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var cw, ch C.gint
+	C.gtk_source_gutter_renderer_text_measure_markup(v.native(), (*C.gchar)(cstr), &cw, &ch)
+	return int(cw), int(ch)
+}
+
+/*
+ * GtkSourceGutterRendererPixbuf
+ */
+
+// SourceGutterRendererPixbuf is a representation of GtkSourceGutterRendererPixbuf.
+type SourceGutterRendererPixbuf struct {
+	SourceGutterRenderer
+}
+
+// native returns a pointer to the underlying GtkSourceGutterRendererPixbuf.
+func (v *SourceGutterRendererPixbuf) native() *C.GtkSourceGutterRendererPixbuf {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceGutterRendererPixbuf(p)
+}
+
+func marshalSourceGutterRendererPixbuf(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceGutterRendererPixbuf(obj), nil
+}
+
+func wrapSourceGutterRendererPixbuf(obj *glib.Object) *SourceGutterRendererPixbuf {
+	return &SourceGutterRendererPixbuf{SourceGutterRenderer{glib.InitiallyUnowned{obj}}}
+}
+
+// SourceGutterRendererPixbufNew is a wrapper around gtk_source_gutter_renderer_pixbuf_new().
+func SourceGutterRendererPixbufNew() (*SourceGutterRendererPixbuf, error) {
+	c := C.gtk_source_gutter_renderer_pixbuf_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceGutterRendererPixbuf(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetPixbuf is a wrapper around gtk_source_gutter_renderer_pixbuf_set_pixbuf().
+func (v *SourceGutterRendererPixbuf) SetPixbuf(pixbuf *gdk.Pixbuf) {
+	C.gtk_source_gutter_renderer_pixbuf_set_pixbuf(v.native(), (*C.GdkPixbuf)(unsafe.Pointer(pixbuf.Native())))
+}
+
+// GetPixbuf is a wrapper around gtk_source_gutter_renderer_pixbuf_get_pixbuf().
+func (v *SourceGutterRendererPixbuf) GetPixbuf() *gdk.Pixbuf {
+	c := C.gtk_source_gutter_renderer_pixbuf_get_pixbuf(v.native())
+	if c == nil {
+		return nil
+	}
+	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}
+}
+
+// SetGIcon is a wrapper around gtk_source_gutter_renderer_pixbuf_set_gicon().
+func (v *SourceGutterRendererPixbuf) SetGIcon(icon *glib.Icon) {
+	C.gtk_source_gutter_renderer_pixbuf_set_gicon(v.native(), (*C.GIcon)(unsafe.Pointer(icon.Native())))
+}
+
+// GetGIcon is a wrapper around gtk_source_gutter_renderer_pixbuf_get_gicon().
+func (v *SourceGutterRendererPixbuf) GetGIcon() *glib.Icon {
+	c := C.gtk_source_gutter_renderer_pixbuf_get_gicon(v.native())
+	if c == nil {
+		return nil
+	}
+	return &glib.Icon{glib.Take(unsafe.Pointer(c))}
+}
+
+// SetIconName is a wrapper around gtk_source_gutter_renderer_pixbuf_set_icon_name().
+func (v *SourceGutterRendererPixbuf) SetIconName(iconName string) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_gutter_renderer_pixbuf_set_icon_name(v.native(), (*C.gchar)(cstr))
+}
+
+// GetIconName is a wrapper around gtk_source_gutter_renderer_pixbuf_get_icon_name().
+func (v *SourceGutterRendererPixbuf) GetIconName() string {
+	c := C.gtk_source_gutter_renderer_pixbuf_get_icon_name(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
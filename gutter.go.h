@@ -0,0 +1,116 @@
+#include <gtk/gtk.h>
+
+static GtkSourceGutterRenderer *
+toGtkSourceGutterRenderer(void *p)
+{
+	return (GTK_SOURCE_GUTTER_RENDERER(p));
+}
+
+static GtkSourceGutterRendererText *
+toGtkSourceGutterRendererText(void *p)
+{
+	return (GTK_SOURCE_GUTTER_RENDERER_TEXT(p));
+}
+
+static GtkSourceGutterRendererPixbuf *
+toGtkSourceGutterRendererPixbuf(void *p)
+{
+	return (GTK_SOURCE_GUTTER_RENDERER_PIXBUF(p));
+}
+
+/*
+ * GoGutterRenderer is a GtkSourceGutterRenderer subclass whose virtual
+ * functions dispatch to a Go SourceGutterRendererImpl.
+ */
+
+extern void goGutterRendererDraw(guintptr id, GtkSourceGutterRenderer *renderer, cairo_t *cr,
+	GdkRectangle *background_area, GdkRectangle *cell_area,
+	GtkTextIter *start, GtkTextIter *end, GtkSourceGutterRendererState state);
+extern void goGutterRendererQueryData(guintptr id, GtkSourceGutterRenderer *renderer,
+	GtkTextIter *start, GtkTextIter *end, GtkSourceGutterRendererState state);
+extern gboolean goGutterRendererQueryActivatable(guintptr id, GtkSourceGutterRenderer *renderer,
+	GtkTextIter *iter, GdkRectangle *area, GdkEvent *event);
+extern void goGutterRendererActivate(guintptr id, GtkSourceGutterRenderer *renderer,
+	GtkTextIter *iter, GdkRectangle *area, GdkEvent *event);
+extern void goGutterRendererFinalize(guintptr id);
+
+typedef struct {
+	GtkSourceGutterRenderer parent_instance;
+	guintptr id;
+} GoGutterRenderer;
+
+typedef struct {
+	GtkSourceGutterRendererClass parent_class;
+} GoGutterRendererClass;
+
+G_DEFINE_TYPE(GoGutterRenderer, go_gutter_renderer, GTK_SOURCE_TYPE_GUTTER_RENDERER)
+
+static void
+go_gutter_renderer_draw(GtkSourceGutterRenderer *renderer, cairo_t *cr,
+                        GdkRectangle *background_area, GdkRectangle *cell_area,
+                        GtkTextIter *start, GtkTextIter *end,
+                        GtkSourceGutterRendererState state)
+{
+	// Chain up so the renderer background is drawn.
+	GTK_SOURCE_GUTTER_RENDERER_CLASS(go_gutter_renderer_parent_class)->draw(renderer, cr,
+		background_area, cell_area, start, end, state);
+	goGutterRendererDraw(((GoGutterRenderer *)renderer)->id, renderer, cr,
+		background_area, cell_area, start, end, state);
+}
+
+static void
+go_gutter_renderer_query_data(GtkSourceGutterRenderer *renderer,
+                              GtkTextIter *start, GtkTextIter *end,
+                              GtkSourceGutterRendererState state)
+{
+	goGutterRendererQueryData(((GoGutterRenderer *)renderer)->id, renderer, start, end, state);
+}
+
+static gboolean
+go_gutter_renderer_query_activatable(GtkSourceGutterRenderer *renderer,
+                                     GtkTextIter *iter, GdkRectangle *area,
+                                     GdkEvent *event)
+{
+	return goGutterRendererQueryActivatable(((GoGutterRenderer *)renderer)->id, renderer,
+		iter, area, event);
+}
+
+static void
+go_gutter_renderer_activate(GtkSourceGutterRenderer *renderer,
+                            GtkTextIter *iter, GdkRectangle *area,
+                            GdkEvent *event)
+{
+	goGutterRendererActivate(((GoGutterRenderer *)renderer)->id, renderer, iter, area, event);
+}
+
+static void
+go_gutter_renderer_finalize(GObject *object)
+{
+	goGutterRendererFinalize(((GoGutterRenderer *)object)->id);
+	G_OBJECT_CLASS(go_gutter_renderer_parent_class)->finalize(object);
+}
+
+static void
+go_gutter_renderer_class_init(GoGutterRendererClass *klass)
+{
+	GtkSourceGutterRendererClass *renderer_class = GTK_SOURCE_GUTTER_RENDERER_CLASS(klass);
+
+	G_OBJECT_CLASS(klass)->finalize = go_gutter_renderer_finalize;
+	renderer_class->draw = go_gutter_renderer_draw;
+	renderer_class->query_data = go_gutter_renderer_query_data;
+	renderer_class->query_activatable = go_gutter_renderer_query_activatable;
+	renderer_class->activate = go_gutter_renderer_activate;
+}
+
+static void
+go_gutter_renderer_init(GoGutterRenderer *self)
+{
+}
+
+static GtkSourceGutterRenderer *
+go_gutter_renderer_new(guintptr id)
+{
+	GoGutterRenderer *self = g_object_new(go_gutter_renderer_get_type(), NULL);
+	self->id = id;
+	return GTK_SOURCE_GUTTER_RENDERER(self);
+}
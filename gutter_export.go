@@ -0,0 +1,74 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func gutterRenderer(id C.guintptr, renderer *C.GtkSourceGutterRenderer) (SourceGutterRendererImpl, *SourceGutterRenderer) {
+	impl := getCallback(uintptr(id)).(SourceGutterRendererImpl)
+	return impl, wrapSourceGutterRenderer(glib.Take(unsafe.Pointer(renderer)))
+}
+
+func gutterRectangle(area *C.GdkRectangle) *gdk.Rectangle {
+	return gdk.WrapRectangle(uintptr(unsafe.Pointer(area)))
+}
+
+// wrapEvent returns a gdk.Event borrowing event. gdk.Event has a single
+// *C.GdkEvent field, but C types of other packages cannot be named here.
+func wrapEvent(event *C.GdkEvent) *gdk.Event {
+	if event == nil {
+		return nil
+	}
+	ev := new(gdk.Event)
+	*(**C.GdkEvent)(unsafe.Pointer(ev)) = event
+	return ev
+}
+
+//export goGutterRendererDraw
+func goGutterRendererDraw(id C.guintptr, renderer *C.GtkSourceGutterRenderer, cr *C.cairo_t,
+	backgroundArea, cellArea *C.GdkRectangle, start, end *C.GtkTextIter, state C.GtkSourceGutterRendererState) {
+
+	impl, r := gutterRenderer(id, renderer)
+	impl.Draw(r, cairo.WrapContext(uintptr(unsafe.Pointer(cr))),
+		gutterRectangle(backgroundArea), gutterRectangle(cellArea),
+		(*gtk.TextIter)(unsafe.Pointer(start)), (*gtk.TextIter)(unsafe.Pointer(end)),
+		SourceGutterRendererState(state))
+}
+
+//export goGutterRendererQueryData
+func goGutterRendererQueryData(id C.guintptr, renderer *C.GtkSourceGutterRenderer,
+	start, end *C.GtkTextIter, state C.GtkSourceGutterRendererState) {
+
+	impl, r := gutterRenderer(id, renderer)
+	impl.QueryData(r, (*gtk.TextIter)(unsafe.Pointer(start)), (*gtk.TextIter)(unsafe.Pointer(end)),
+		SourceGutterRendererState(state))
+}
+
+//export goGutterRendererQueryActivatable
+func goGutterRendererQueryActivatable(id C.guintptr, renderer *C.GtkSourceGutterRenderer,
+	iter *C.GtkTextIter, area *C.GdkRectangle, event *C.GdkEvent) C.gboolean {
+
+	impl, r := gutterRenderer(id, renderer)
+	return gbool(impl.QueryActivatable(r, (*gtk.TextIter)(unsafe.Pointer(iter)),
+		gutterRectangle(area), wrapEvent(event)))
+}
+
+//export goGutterRendererActivate
+func goGutterRendererActivate(id C.guintptr, renderer *C.GtkSourceGutterRenderer,
+	iter *C.GtkTextIter, area *C.GdkRectangle, event *C.GdkEvent) {
+
+	impl, r := gutterRenderer(id, renderer)
+	impl.Activate(r, (*gtk.TextIter)(unsafe.Pointer(iter)), gutterRectangle(area), wrapEvent(event))
+}
+
+//export goGutterRendererFinalize
+func goGutterRendererFinalize(id C.guintptr) {
+	deleteCallback(uintptr(id))
+}
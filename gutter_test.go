@@ -0,0 +1,178 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/cairo"
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestSourceGutterRendererProperties(t *testing.T) {
+	requireGTK(t)
+
+	renderer, err := SourceGutterRendererTextNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetSize(20)
+	if got := renderer.GetSize(); got != 20 {
+		t.Errorf("GetSize() = %d, want 20", got)
+	}
+	renderer.SetVisible(false)
+	if renderer.GetVisible() {
+		t.Error("GetVisible() = true after SetVisible(false)")
+	}
+	renderer.SetPadding(2, 3)
+	if x, y := renderer.GetPadding(); x != 2 || y != 3 {
+		t.Errorf("GetPadding() = %d, %d, want 2, 3", x, y)
+	}
+	renderer.SetAlignment(0.5, 1)
+	if x, y := renderer.GetAlignment(); x != 0.5 || y != 1 {
+		t.Errorf("GetAlignment() = %v, %v, want 0.5, 1", x, y)
+	}
+	renderer.SetAlignmentMode(SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_FIRST)
+	if got := renderer.GetAlignmentMode(); got != SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_FIRST {
+		t.Errorf("GetAlignmentMode() = %v, want SOURCE_GUTTER_RENDERER_ALIGNMENT_MODE_FIRST", got)
+	}
+
+	if _, ok := renderer.GetBackground(); ok {
+		t.Error("GetBackground() ok = true before SetBackground")
+	}
+	renderer.SetBackground(gdk.NewRGBA(0, 0, 1, 1))
+	if rgba, ok := renderer.GetBackground(); !ok || rgba.GetBlue() != 1 {
+		t.Errorf("GetBackground() = %v, %v, want blue", rgba, ok)
+	}
+	renderer.SetBackground(nil)
+	if _, ok := renderer.GetBackground(); ok {
+		t.Error("GetBackground() ok = true after SetBackground(nil)")
+	}
+
+	w1, h1 := renderer.Measure("1")
+	w5, h5 := renderer.Measure("12345")
+	if w1 <= 0 || w5 <= w1 || h5 != h1 {
+		t.Errorf("Measure() = %dx%d for 1 digit and %dx%d for 5, want wider text to measure wider", w1, h1, w5, h5)
+	}
+	if w, _ := renderer.MeasureMarkup("<b>12345</b>"); w <= 0 {
+		t.Errorf("MeasureMarkup() width = %d, want a positive width", w)
+	}
+}
+
+func TestSourceGutterRendererPixbuf(t *testing.T) {
+	requireGTK(t)
+
+	renderer, err := SourceGutterRendererPixbufNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetIconName("dialog-information")
+	if got := renderer.GetIconName(); got != "dialog-information" {
+		t.Errorf("GetIconName() = %q, want %q", got, "dialog-information")
+	}
+
+	pixbuf, err := gdk.PixbufNew(gdk.COLORSPACE_RGB, true, 8, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetPixbuf(pixbuf)
+	if got := renderer.GetPixbuf(); got == nil || got.Native() != pixbuf.Native() {
+		t.Errorf("GetPixbuf() = %v, want the pixbuf set", got)
+	}
+
+	icon, err := glib.IconNewForString("dialog-question")
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetGIcon(icon)
+	if got := renderer.GetGIcon(); got == nil || got.Native() != icon.Native() {
+		t.Errorf("GetGIcon() = %v, want the icon set", got)
+	}
+}
+
+func TestSourceGutterInsertRemove(t *testing.T) {
+	requireGTK(t)
+
+	view, err := SourceViewNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gutter, err := view.GetGutter(gtk.TEXT_WINDOW_LEFT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := SourceGutterRendererTextNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gutter.Insert(renderer, 5) {
+		t.Fatal("Insert() = false")
+	}
+	if got, err := renderer.GetView(); err != nil || got.Native() != view.Native() {
+		t.Errorf("GetView() = %v, %v, want the view of the gutter", got, err)
+	}
+	if got := renderer.GetWindowType(); got != gtk.TEXT_WINDOW_LEFT {
+		t.Errorf("GetWindowType() = %v, want TEXT_WINDOW_LEFT", got)
+	}
+	gutter.Reorder(renderer, 0)
+
+	gutter.Remove(renderer)
+	if _, err := renderer.GetView(); err == nil {
+		t.Error("GetView() succeeded after the renderer was removed")
+	}
+}
+
+// testGutterRenderer records which virtual functions of a renderer created
+// with SourceGutterRendererNew were called.
+type testGutterRenderer struct {
+	queried, drawn bool
+}
+
+func (r *testGutterRenderer) Draw(renderer *SourceGutterRenderer, cr *cairo.Context, backgroundArea, cellArea *gdk.Rectangle,
+	start, end *gtk.TextIter, state SourceGutterRendererState) {
+	r.drawn = true
+}
+
+func (r *testGutterRenderer) QueryData(renderer *SourceGutterRenderer, start, end *gtk.TextIter, state SourceGutterRendererState) {
+	r.queried = true
+}
+
+func (r *testGutterRenderer) QueryActivatable(renderer *SourceGutterRenderer, iter *gtk.TextIter, area *gdk.Rectangle, event *gdk.Event) bool {
+	return false
+}
+
+func (r *testGutterRenderer) Activate(renderer *SourceGutterRenderer, iter *gtk.TextIter, area *gdk.Rectangle, event *gdk.Event) {
+}
+
+func TestSourceGutterRendererNew(t *testing.T) {
+	requireGTK(t)
+
+	impl := &testGutterRenderer{}
+	renderer, err := SourceGutterRendererNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	renderer.SetSize(10)
+
+	buffer := newTestBuffer(t, "one\ntwo\nthree\n")
+	view, err := SourceViewNewWithBuffer(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gutter, err := view.GetGutter(gtk.TEXT_WINDOW_LEFT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gutter.Insert(renderer, 0)
+
+	window, err := gtk.OffscreenWindowNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer window.Destroy()
+	window.SetDefaultSize(200, 100)
+	window.Add(view)
+	window.ShowAll()
+
+	runMainLoopUntil(t, func() bool { return impl.queried && impl.drawn })
+}
@@ -0,0 +1,261 @@
+package sourceview
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+const testScheme = `<?xml version="1.0" encoding="UTF-8"?>
+<style-scheme id="sourceview-test" name="Sourceview Test" version="1.0">
+  <author>sourceview tests</author>
+  <description>Scheme loaded by the integration tests.</description>
+  <style name="def:comment" foreground="#00ff00" bold="true" italic="true"/>
+</style-scheme>
+`
+
+func TestSourceViewConstructors(t *testing.T) {
+	requireGTK(t)
+
+	view, err := SourceViewNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := view.GetBuffer(); err != nil {
+		t.Errorf("SourceViewNew().GetBuffer() = %v", err)
+	}
+	view.SetShowLineNumbers(true)
+	if !view.GetShowLineNumbers() {
+		t.Error("GetShowLineNumbers() = false after SetShowLineNumbers(true)")
+	}
+	view.SetTabWidth(3)
+	if got := view.GetTabWidth(); got != 3 {
+		t.Errorf("GetTabWidth() = %d, want 3", got)
+	}
+
+	buffer, err := SourceBufferNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, err = SourceViewNewWithBuffer(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := view.GetBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Native() != buffer.Native() {
+		t.Error("SourceViewNewWithBuffer() view does not use the buffer")
+	}
+
+	if _, err := SourceMapNew(); err != nil {
+		t.Errorf("SourceMapNew() = %v", err)
+	}
+}
+
+func TestSourceLanguageManagerGetDefault(t *testing.T) {
+	requireGTK(t)
+
+	lm, err := SourceLanguageManagerGetDefault()
+	if err != nil {
+		t.Fatal(err)
+	}
+	language, err := lm.GetLanguage("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := language.GetID(); got != "c" {
+		t.Errorf("GetID() = %q, want %q", got, "c")
+	}
+	if got := language.GetName(); got != "C" {
+		t.Errorf("GetName() = %q, want %q", got, "C")
+	}
+
+	guessed, err := lm.GuessLanguage("main.c", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := guessed.GetID(); got != "c" {
+		t.Errorf("GuessLanguage(\"main.c\") = %q, want %q", got, "c")
+	}
+
+	buffer, err := SourceBufferNewWithLanguage(language)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := buffer.GetLanguage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetID() != "c" {
+		t.Errorf("SourceBufferNewWithLanguage() buffer has language %q, want %q", got.GetID(), "c")
+	}
+}
+
+func TestSourceStyleSchemeSearchPath(t *testing.T) {
+	requireGTK(t)
+
+	dir, err := ioutil.TempDir("", "sourceview-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sourceview-test.xml")
+	if err := ioutil.WriteFile(path, []byte(testScheme), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sm, err := SourceStyleSchemeManagerNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sm.SetSearchPath([]string{dir})
+	if got := sm.GetSearchPath(); len(got) != 1 || got[0] != dir {
+		t.Errorf("GetSearchPath() = %q, want [%q]", got, dir)
+	}
+	if got := sm.GetSchemeIDs(); len(got) != 1 || got[0] != "sourceview-test" {
+		t.Errorf("GetSchemeIDs() = %q, want [\"sourceview-test\"]", got)
+	}
+
+	scheme := sm.GetScheme("sourceview-test")
+	if scheme == nil {
+		t.Fatal("GetScheme(\"sourceview-test\") returned nil")
+	}
+	if got, err := scheme.GetName(); err != nil || got != "Sourceview Test" {
+		t.Errorf("GetName() = %q, %v, want %q", got, err, "Sourceview Test")
+	}
+	if got, err := scheme.GetFileName(); err != nil || got != path {
+		t.Errorf("GetFileName() = %q, %v, want %q", got, err, path)
+	}
+
+	style, err := scheme.GetStyle("def:comment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := style.GetForeground(); got != "#00ff00" {
+		t.Errorf("GetForeground() = %q, want %q", got, "#00ff00")
+	}
+	if !style.GetBold() || !style.GetItalic() {
+		t.Errorf("GetBold(), GetItalic() = %v, %v, want true, true", style.GetBold(), style.GetItalic())
+	}
+
+	tag, err := gtk.TextTagNew("comment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	style.Apply(tag)
+	for _, prop := range []string{"foreground-set", "weight-set", "style-set"} {
+		if v, err := tag.GetProperty(prop); err != nil || v != true {
+			t.Errorf("tag property %s = %v, %v after Apply, want true", prop, v, err)
+		}
+	}
+	if v, err := tag.GetProperty("weight"); err != nil || v != 700 {
+		t.Errorf("tag property weight = %v, %v after Apply, want 700", v, err)
+	}
+}
+
+func TestBuilderDemoGlade(t *testing.T) {
+	requireGTK(t)
+
+	builder, err := gtk.BuilderNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.AddFromString(demoGlade(t)); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := builder.GetObject("sv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	view, ok := obj.(*SourceView)
+	if !ok {
+		t.Fatalf("builder object sv is a %T, want *SourceView", obj)
+	}
+	if !view.GetShowLineNumbers() {
+		t.Error("GetShowLineNumbers() = false, want true")
+	}
+	if !view.GetAutoIndent() {
+		t.Error("GetAutoIndent() = false, want true")
+	}
+	if got := view.GetTabWidth(); got != 4 {
+		t.Errorf("GetTabWidth() = %d, want 4", got)
+	}
+}
+
+// demoGlade returns the Glade XML of the demo program, so that the test
+// builds exactly what the demo does.
+func demoGlade(t *testing.T) string {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), filepath.Join("sourceview3-demo", "main.go"), nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range f.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range d.Specs {
+			s := spec.(*ast.ValueSpec)
+			if len(s.Names) != 1 || s.Names[0].Name != "glade" {
+				continue
+			}
+			glade, err := strconv.Unquote(s.Values[0].(*ast.BasicLit).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return glade
+		}
+	}
+	t.Fatal("sourceview3-demo/main.go declares no glade constant")
+	return ""
+}
+
+// newTestBuffer returns a new buffer holding text.
+func newTestBuffer(t *testing.T, text string) *SourceBuffer {
+	t.Helper()
+	buffer, err := SourceBufferNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer.SetText(text)
+	return buffer
+}
+
+// bufferText returns the whole text of buffer.
+func bufferText(t *testing.T, buffer *SourceBuffer) string {
+	t.Helper()
+	start, end := buffer.GetBounds()
+	text, err := buffer.GetText(start, end, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return text
+}
+
+// runMainLoopUntil iterates the main loop until done returns true, for the
+// callbacks of asynchronous operations to run.
+func runMainLoopUntil(t *testing.T, done func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !done() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the main loop")
+		}
+		if gtk.EventsPending() {
+			gtk.MainIterationDo(false)
+		} else {
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
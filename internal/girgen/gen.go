@@ -0,0 +1,493 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/build/constraint"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// localType is a class, interface, enumeration or bitfield of the
+// namespace being generated.
+type localType struct {
+	gir      *class
+	goName   string
+	cType    string
+	iface    bool
+	floating bool // derives from GInitiallyUnowned
+
+	resolved bool
+	generate bool   // the struct and its boilerplate are generated
+	embed    string // embedded field of a generated struct
+	literal  string // struct literal wrapping obj in a generated wrap function
+	reason   string // why the type can be neither generated nor used
+}
+
+// generator turns a namespace into Go code and a cgo header.
+type generator struct {
+	ns      *namespace
+	hw      *handWritten
+	include string // C header declaring the namespace
+	header  string // name of the generated cgo header
+	build   string // build constraint of the generated Go file
+
+	locals map[string]*localType // by GIR name
+	enums  map[string]string     // Go type by GIR name
+	casts  map[string]string     // get_type function by C type
+
+	names   map[string]bool // Go functions and methods generated so far
+	skipped []string
+
+	out *bytes.Buffer // where printf writes
+}
+
+func newGenerator(ns *namespace, hw *handWritten) *generator {
+	g := &generator{
+		ns:     ns,
+		hw:     hw,
+		locals: map[string]*localType{},
+		enums:  map[string]string{},
+		casts:  map[string]string{},
+		names:  map[string]bool{},
+	}
+	for _, e := range append(append([]enum(nil), ns.Enums...), ns.Bitfields...) {
+		g.enums[e.Name] = g.goTypeName(e.CType)
+	}
+	for i := range ns.Classes {
+		c := &ns.Classes[i]
+		g.locals[c.Name] = &localType{gir: c, goName: g.goTypeName(c.CType), cType: c.CType}
+	}
+	for i := range ns.Interfaces {
+		c := &ns.Interfaces[i]
+		g.locals[c.Name] = &localType{gir: c, goName: g.goTypeName(c.CType), cType: c.CType, iface: true}
+	}
+	for _, lt := range g.locals {
+		g.resolve(lt)
+	}
+	return g
+}
+
+// goTypeName strips the namespace prefix, Gtk, from a C type name, so that
+// GtkSourceBuffer becomes SourceBuffer.
+func (g *generator) goTypeName(ctype string) string {
+	return strings.TrimPrefix(ctype, g.ns.Prefix)
+}
+
+// resolve decides how lt is wrapped: by hand, by generated code, or not at
+// all when its parent has no Go counterpart.
+func (g *generator) resolve(lt *localType) {
+	if lt.resolved {
+		return
+	}
+	lt.resolved = true
+	c := lt.gir
+
+	var parentReason string
+	switch {
+	case lt.iface:
+		lt.embed = "*glib.Object"
+		lt.literal = "obj"
+	case g.locals[c.Parent] != nil:
+		p := g.locals[c.Parent]
+		g.resolve(p)
+		lt.floating = p.floating
+		lt.embed = p.goName
+		lt.literal = "*wrap" + p.goName + "(obj)"
+		if p.reason != "" {
+			parentReason = "parent " + p.goName + ": " + p.reason
+		}
+	case externalClasses[c.Parent].goType != "":
+		ec := externalClasses[c.Parent]
+		lt.floating = g.externalFloating(c.Parent)
+		lt.embed = ec.goType
+		lt.literal = g.externalLiteral(c.Parent, "obj")
+		if ec.parent == "" {
+			lt.embed = "*" + ec.goType
+		}
+	default:
+		parentReason = "parent " + c.Parent + " has no gotk3 wrapper"
+	}
+
+	switch {
+	case g.hw.interfaces[lt.goName]:
+		lt.reason = "implemented as a Go interface"
+	case g.hw.types[lt.goName] && !g.hw.methods[lt.goName+".native"]:
+		lt.reason = "hand-written without native()"
+	case g.hw.types[lt.goName] && !g.hw.funcs["wrap"+lt.goName]:
+		lt.reason = "hand-written without wrap" + lt.goName + "()"
+	case g.hw.types[lt.goName]:
+	case g.hw.skip[lt.cType]:
+		lt.reason = "listed in the skip file"
+	case c.Deprecated == "1":
+		lt.reason = "deprecated"
+	case c.GetType == "":
+		lt.reason = "no GType"
+	case parentReason != "":
+		lt.reason = parentReason
+	default:
+		lt.generate = true
+	}
+}
+
+// usable reports an error if values of lt cannot appear in generated code.
+func (g *generator) usable(lt *localType) error {
+	if lt.reason != "" {
+		return fmt.Errorf("%s %s", lt.goName, lt.reason)
+	}
+	return nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g.out, format, args...)
+}
+
+func (g *generator) skip(cname, reason string) {
+	g.skipped = append(g.skipped, cname+": "+reason)
+}
+
+// generate writes the Go file and the cgo header.
+func (g *generator) generate() (goSrc, hSrc []byte, err error) {
+	var body bytes.Buffer
+	g.out = &body
+	g.genInit()
+	for i := range g.ns.Enums {
+		g.genEnum(&g.ns.Enums[i])
+	}
+	for i := range g.ns.Bitfields {
+		g.genEnum(&g.ns.Bitfields[i])
+	}
+	for _, c := range g.ns.Interfaces {
+		g.genType(g.locals[c.Name])
+	}
+	for _, c := range g.ns.Classes {
+		g.genType(g.locals[c.Name])
+	}
+	g.section("Functions", func() {
+		for i := range g.ns.Functions {
+			g.genFunc(&g.ns.Functions[i], nil, false)
+		}
+	})
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by girgen. DO NOT EDIT.\n\n")
+	if g.build != "" {
+		expr, err := constraint.Parse("//go:build " + g.build)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The +build lines are for Go 1.16, which go.mod still allows.
+		plus, err := constraint.PlusBuildLines(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		fmt.Fprintf(&src, "//go:build %s\n%s\n\n", expr, strings.Join(plus, "\n"))
+	}
+	fmt.Fprintf(&src, "package sourceview\n\n")
+	fmt.Fprintf(&src, "// #include <stdlib.h>\n// #include <%s>\n// #include %q\n", g.include, g.header)
+	fmt.Fprintf(&src, "import \"C\"\n")
+	src.WriteString(imports(body.String()))
+	src.Write(body.Bytes())
+
+	goSrc, err = format.Source(src.Bytes())
+	if err != nil {
+		return src.Bytes(), nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return goSrc, g.genHeader(), nil
+}
+
+// section runs f and, if it wrote anything, puts it under a banner comment
+// like the ones separating types in the hand-written files.
+func (g *generator) section(title string, f func()) {
+	out := g.out
+	var b bytes.Buffer
+	g.out = &b
+	f()
+	g.out = out
+	if b.Len() > 0 {
+		g.printf("/*\n * %s\n */\n\n", title)
+		out.Write(b.Bytes())
+	}
+}
+
+// imports returns the import block for the packages body uses.
+func imports(body string) string {
+	var std, pkgs []string
+	if strings.Contains(body, "unsafe.") {
+		std = append(std, `"unsafe"`)
+	}
+	for _, pkg := range []string{"gdk", "glib", "gtk"} {
+		if strings.Contains(body, pkg+".") {
+			pkgs = append(pkgs, `"github.com/gotk3/gotk3/`+pkg+`"`)
+		}
+	}
+	if len(std)+len(pkgs) == 0 {
+		return "\n"
+	}
+	s := "import (\n"
+	for _, p := range std {
+		s += "\t" + p + "\n"
+	}
+	if len(std) > 0 && len(pkgs) > 0 {
+		s += "\n"
+	}
+	for _, p := range pkgs {
+		s += "\t" + p + "\n"
+	}
+	return s + ")\n\n"
+}
+
+// genInit registers the marshalers and wrap functions of generated types.
+func (g *generator) genInit() {
+	var types []*localType
+	for _, c := range append(append([]class(nil), g.ns.Classes...), g.ns.Interfaces...) {
+		if lt := g.locals[c.Name]; lt.generate {
+			types = append(types, lt)
+		}
+	}
+	if len(types) == 0 {
+		return
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].goName < types[j].goName })
+
+	g.printf("func init() {\n\ttm := []glib.TypeMarshaler{\n")
+	for _, lt := range types {
+		g.printf("{glib.Type(C.%s()), marshal%s},\n", lt.gir.GetType, lt.goName)
+	}
+	g.printf("}\nglib.RegisterGValueMarshalers(tm)\n\n")
+	for _, lt := range types {
+		g.printf("gtk.WrapMap[%q] = wrap%s\n", lt.cType, lt.goName)
+	}
+	g.printf("}\n\n")
+}
+
+// genEnum declares an enumeration or bitfield and whichever of its values
+// are not declared by hand.
+func (g *generator) genEnum(e *enum) {
+	goType := g.goTypeName(e.CType)
+	if g.hw.skip[e.CType] {
+		return
+	}
+	var consts []member
+	for _, m := range e.Members {
+		if !g.hw.funcs[g.constName(m)] && !g.hw.skip[m.CIdentifier] {
+			consts = append(consts, m)
+		}
+	}
+	declare := !g.hw.types[goType]
+	if !declare && len(consts) == 0 {
+		return
+	}
+
+	if declare {
+		g.printf("// %s is a representation of %s.\ntype %s int\n\n", goType, e.CType, goType)
+	}
+	g.printf("const (\n")
+	for _, m := range consts {
+		g.printf("%s %s = C.%s\n", g.constName(m), goType, m.CIdentifier)
+	}
+	g.printf(")\n\n")
+}
+
+// constName strips the GTK_ prefix from the C name of an enumeration value,
+// like the hand-written constants do.
+func (g *generator) constName(m member) string {
+	return strings.TrimPrefix(m.CIdentifier, strings.ToUpper(g.ns.Prefix)+"_")
+}
+
+// genType writes the boilerplate of a generated type, then the wrappers of
+// its constructors, methods and functions missing from the hand-written
+// code.
+func (g *generator) genType(lt *localType) {
+	c := lt.gir
+	if lt.reason != "" {
+		g.skip(lt.cType, lt.reason)
+		for _, f := range append(append(append([]function(nil), c.Constructors...), c.Methods...), c.Functions...) {
+			if !g.hw.covers(f.CIdentifier) {
+				g.skip(f.CIdentifier, lt.goName+" "+lt.reason)
+			}
+		}
+		return
+	}
+
+	g.section(lt.cType, func() {
+		g.genTypeBody(lt)
+	})
+}
+
+func (g *generator) genTypeBody(lt *localType) {
+	c := lt.gir
+	if lt.generate {
+		g.casts[lt.cType] = c.GetType
+		g.printf("// %s is a representation of %s.\n", lt.goName, lt.cType)
+		g.printf("type %s struct {\n%s\n}\n\n", lt.goName, lt.embed)
+		g.printf("// native returns a pointer to the underlying %s.\n", lt.cType)
+		g.printf("func (v *%s) native() *C.%s {\n", lt.goName, lt.cType)
+		g.printf("if v == nil || v.GObject == nil {\nreturn nil\n}\n")
+		g.printf("p := unsafe.Pointer(v.GObject)\nreturn C.to%s(p)\n}\n\n", lt.cType)
+		g.printf("func marshal%s(p uintptr) (interface{}, error) {\n", lt.goName)
+		g.printf("c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))\n")
+		g.printf("obj := glib.Take(unsafe.Pointer(c))\nreturn wrap%s(obj), nil\n}\n\n", lt.goName)
+		g.printf("func wrap%s(obj *glib.Object) *%s {\n", lt.goName, lt.goName)
+		g.printf("return &%s{%s}\n}\n\n", lt.goName, lt.literal)
+	}
+
+	for i := range c.Constructors {
+		g.genFunc(&c.Constructors[i], lt, false)
+	}
+	for i := range c.Methods {
+		g.genFunc(&c.Methods[i], lt, true)
+	}
+	for i := range c.Functions {
+		g.genFunc(&c.Functions[i], nil, false)
+	}
+}
+
+// genFunc writes the wrapper of a function. Constructors pass the
+// constructed type as owner; methods pass their receiver type.
+func (g *generator) genFunc(f *function, owner *localType, method bool) {
+	cname := f.CIdentifier
+	switch {
+	case g.hw.covers(cname):
+		return
+	case f.Deprecated == "1":
+		g.skip(cname, "deprecated")
+		return
+	case f.Introspectable == "0":
+		g.skip(cname, "not introspectable")
+		return
+	}
+
+	name := camel(strings.TrimPrefix(cname, strings.ToLower(g.ns.Prefix)+"_"))
+	key := name
+	if method {
+		name = camel(f.Name)
+		key = owner.goName + "." + name
+		if g.hw.methods[key] {
+			g.skip(cname, key+" is declared by hand")
+			return
+		}
+		if embedded := owner.embed[strings.LastIndex(owner.embed, ".")+1:]; name == strings.TrimPrefix(embedded, "*") {
+			g.skip(cname, key+" clashes with the embedded "+owner.embed)
+			return
+		}
+	} else if g.hw.funcs[name] || g.hw.types[name] {
+		g.skip(cname, name+" is declared by hand")
+		return
+	}
+	if g.names[key] {
+		g.skip(cname, key+" is generated for another function")
+		return
+	}
+
+	var params []*param
+	for i := range f.Parameters.Params {
+		p, err := g.mapParam(&f.Parameters.Params[i])
+		if err != nil {
+			g.skip(cname, err.Error())
+			return
+		}
+		params = append(params, p)
+	}
+	var ctor *localType
+	if !method {
+		ctor = owner
+	}
+	res, err := g.mapResult(&f.ReturnValue, ctor)
+	if err != nil {
+		g.skip(cname, err.Error())
+		return
+	}
+	g.names[key] = true
+
+	throws := f.Throws == "1"
+	// A boolean result next to a GError only repeats whether the error is
+	// set.
+	if throws && res != nil && res.goType == "bool" {
+		res = nil
+	}
+
+	var goParams, args []string
+	if method {
+		args = append(args, "v.native()")
+	}
+	for _, p := range params {
+		goParams = append(goParams, p.name+" "+p.goType)
+		args = append(args, p.arg)
+	}
+	if throws {
+		args = append(args, "&err")
+	}
+
+	var results string
+	switch {
+	case res == nil && throws:
+		results = " error"
+	case res == nil:
+	case res.object || throws:
+		results = " (" + res.goType + ", error)"
+	default:
+		results = " " + res.goType
+	}
+
+	g.printf("// %s is a wrapper around %s().\n", name, cname)
+	if method {
+		g.printf("func (v *%s) %s(%s)%s {\n", owner.goName, name, strings.Join(goParams, ", "), results)
+	} else {
+		g.printf("func %s(%s)%s {\n", name, strings.Join(goParams, ", "), results)
+	}
+	for _, p := range params {
+		for _, s := range p.pre {
+			g.printf("%s\n", s)
+		}
+	}
+	if throws {
+		g.printf("var err *C.GError\n")
+	}
+
+	call := "C." + cname + "(" + strings.Join(args, ", ") + ")"
+	switch {
+	case res == nil && throws:
+		g.printf("%s\nreturn goError(err)\n", call)
+	case res == nil:
+		g.printf("%s\n", call)
+	case !res.object && !throws && res.free == "":
+		g.printf("return %s\n", fmt.Sprintf(res.conv, call))
+	default:
+		g.printf("c := %s\n", call)
+		if throws {
+			g.printf("if err != nil {\nreturn %s, goError(err)\n}\n", res.zero)
+		}
+		if res.object {
+			g.printf("if c == nil {\nreturn nil, errNilPtr\n}\n")
+		}
+		if res.free != "" {
+			g.printf("defer %s\n", res.free)
+		}
+		if res.object || throws {
+			g.printf("return %s, nil\n", fmt.Sprintf(res.conv, "c"))
+		} else {
+			g.printf("return %s\n", fmt.Sprintf(res.conv, "c"))
+		}
+	}
+	g.printf("}\n\n")
+}
+
+// genHeader returns the cgo header with the casts used by the generated
+// code.
+func (g *generator) genHeader() []byte {
+	var ctypes []string
+	for ctype := range g.casts {
+		ctypes = append(ctypes, ctype)
+	}
+	sort.Strings(ctypes)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by girgen. DO NOT EDIT.\n\n#include <gtk/gtk.h>\n")
+	for _, ctype := range ctypes {
+		fmt.Fprintf(&b, "\nstatic %s *\nto%s(void *p)\n{\n", ctype, ctype)
+		fmt.Fprintf(&b, "\treturn (G_TYPE_CHECK_INSTANCE_CAST(p, %s(), %s));\n}\n", g.casts[ctype], ctype)
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/xml"
+	"os"
+)
+
+// repository is the root element of a GIR file. Only the parts girgen needs
+// are decoded.
+type repository struct {
+	Namespace namespace `xml:"namespace"`
+}
+
+type namespace struct {
+	Name       string     `xml:"name,attr"`
+	Prefix     string     `xml:"http://www.gtk.org/introspection/c/1.0 identifier-prefixes,attr"`
+	Classes    []class    `xml:"class"`
+	Interfaces []class    `xml:"interface"`
+	Enums      []enum     `xml:"enumeration"`
+	Bitfields  []enum     `xml:"bitfield"`
+	Functions  []function `xml:"function"`
+}
+
+// class describes both classes and interfaces, which share the attributes
+// girgen looks at.
+type class struct {
+	Name         string     `xml:"name,attr"`
+	CType        string     `xml:"http://www.gtk.org/introspection/c/1.0 type,attr"`
+	Parent       string     `xml:"parent,attr"`
+	GetType      string     `xml:"http://www.gtk.org/introspection/glib/1.0 get-type,attr"`
+	Deprecated   string     `xml:"deprecated,attr"`
+	Constructors []function `xml:"constructor"`
+	Methods      []function `xml:"method"`
+	Functions    []function `xml:"function"`
+}
+
+type function struct {
+	Name           string      `xml:"name,attr"`
+	CIdentifier    string      `xml:"http://www.gtk.org/introspection/c/1.0 identifier,attr"`
+	Deprecated     string      `xml:"deprecated,attr"`
+	Introspectable string      `xml:"introspectable,attr"`
+	Throws         string      `xml:"throws,attr"`
+	ReturnValue    returnValue `xml:"return-value"`
+	Parameters     parameters  `xml:"parameters"`
+}
+
+type parameters struct {
+	Instance *parameter  `xml:"instance-parameter"`
+	Params   []parameter `xml:"parameter"`
+}
+
+type parameter struct {
+	Name      string    `xml:"name,attr"`
+	Direction string    `xml:"direction,attr"`
+	Transfer  string    `xml:"transfer-ownership,attr"`
+	Nullable  string    `xml:"nullable,attr"`
+	AllowNone string    `xml:"allow-none,attr"`
+	Type      *typeRef  `xml:"type"`
+	Array     *arrayRef `xml:"array"`
+	Varargs   *struct{} `xml:"varargs"`
+}
+
+type returnValue struct {
+	Transfer string    `xml:"transfer-ownership,attr"`
+	Nullable string    `xml:"nullable,attr"`
+	Type     *typeRef  `xml:"type"`
+	Array    *arrayRef `xml:"array"`
+}
+
+type typeRef struct {
+	Name  string `xml:"name,attr"`
+	CType string `xml:"http://www.gtk.org/introspection/c/1.0 type,attr"`
+}
+
+type arrayRef struct {
+	CType          string   `xml:"http://www.gtk.org/introspection/c/1.0 type,attr"`
+	ZeroTerminated string   `xml:"zero-terminated,attr"`
+	Length         string   `xml:"length,attr"`
+	Type           *typeRef `xml:"type"`
+}
+
+type enum struct {
+	Name    string   `xml:"name,attr"`
+	CType   string   `xml:"http://www.gtk.org/introspection/c/1.0 type,attr"`
+	Members []member `xml:"member"`
+}
+
+type member struct {
+	Name        string `xml:"name,attr"`
+	CIdentifier string `xml:"http://www.gtk.org/introspection/c/1.0 identifier,attr"`
+}
+
+// readGIR decodes the GIR file at path.
+func readGIR(path string) (*repository, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var repo repository
+	if err := xml.NewDecoder(f).Decode(&repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
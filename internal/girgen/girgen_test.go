@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// TestGolden runs girgen on testdata/GtkSource.gir and the hand-written
+// package in testdata/pkg, and compares the output with the golden files.
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		out   string
+		tags  []string
+		build string
+	}{
+		{out: "sourceview_gen"},
+		{out: "sourceview4_gen", tags: []string{"gtksourceview4"}, build: "gtksourceview4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.out, func(t *testing.T) {
+			dir := copyPackage(t, filepath.Join("testdata", "pkg"))
+			err := run(filepath.Join("testdata", "GtkSource.gir"), dir, tt.out,
+				"gtksourceview/gtksource.h", "girgen.skip", tt.tags, tt.build, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range []string{tt.out + ".go", tt.out + ".go.h"} {
+				got, err := ioutil.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				golden := filepath.Join("testdata", name+".golden")
+				if *update {
+					if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				want, err := ioutil.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("%s differs from %s; check the output and run go test -update", name, golden)
+				}
+			}
+		})
+	}
+}
+
+// copyPackage copies the files in dir to a temporary directory, where
+// girgen can write its output.
+func copyPackage(t *testing.T, dir string) string {
+	t.Helper()
+	tmp, err := ioutil.TempDir("", "girgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tmp) })
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fi := range files {
+		b, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(tmp, fi.Name()), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tmp
+}
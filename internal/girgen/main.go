@@ -0,0 +1,103 @@
+// Command girgen generates the parts of the sourceview bindings that follow
+// mechanically from the GtkSource GObject introspection data: typed
+// constants for enumerations and flags, the native/marshal/wrap boilerplate
+// and to* casts of each type, and wrappers for constructors, methods and
+// functions whose parameters and results it knows how to convert.
+//
+// It is run by go generate from the package directory. By default it reads
+// GtkSource-3.0.gir and writes sourceview_gen.go and sourceview_gen.go.h;
+// the go:generate directives in gtksourceview3.go and gtksourceview4.go set
+// the flags for each GtkSourceView version, so
+//
+//	go generate
+//	go generate -tags gtksourceview4
+//
+// regenerate the bindings for GtkSourceView 3 and 4 respectively.
+//
+// Hand-written code always wins. Before generating anything, girgen parses
+// the package's other Go files built with -tags, and does not generate
+//
+//   - types, functions, constants or methods already declared there,
+//   - wrappers for C functions already called there, whatever the Go
+//     wrapper is named,
+//   - anything listed in the skip file, girgen.skip by default, which holds
+//     one C function, type or constant name per line.
+//
+// Functions taking callbacks, out parameters or types girgen cannot convert
+// are left for hand-written code; -v lists them with the reason. Interfaces
+// meant to be implemented in Go, such as GtkSourceCompletionProvider, are
+// written by hand as Go interfaces, and girgen skips everything involving
+// them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	var (
+		girPath  = flag.String("gir", "/usr/share/gir-1.0/GtkSource-3.0.gir", "GIR file to read")
+		dir      = flag.String("dir", ".", "package directory")
+		out      = flag.String("out", "sourceview_gen", "base name of the generated .go and .go.h files")
+		include  = flag.String("include", "gtksourceview/gtksource.h", "C header declaring the library")
+		skipFile = flag.String("skip", "girgen.skip", "file listing C names not to generate, relative to -dir")
+		tags     = flag.String("tags", "", "comma-separated build tags selecting the hand-written files")
+		build    = flag.String("build", "", "build constraint of the generated Go file")
+		verbose  = flag.Bool("v", false, "list what was not generated and why")
+	)
+	flag.Parse()
+
+	var tagList []string
+	if *tags != "" {
+		tagList = strings.Split(*tags, ",")
+	}
+	if err := run(*girPath, *dir, *out, *include, *skipFile, tagList, *build, *verbose); err != nil {
+		fmt.Fprintln(os.Stderr, "girgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(girPath, dir, out, include, skipFile string, tags []string, build string, verbose bool) error {
+	repo, err := readGIR(girPath)
+	if err != nil {
+		return err
+	}
+	hw, err := scanPackage(dir, tags)
+	if err != nil {
+		return err
+	}
+	if err := hw.readSkipFile(filepath.Join(dir, skipFile)); err != nil {
+		return err
+	}
+
+	g := newGenerator(&repo.Namespace, hw)
+	g.include = include
+	g.header = out + ".go.h"
+	g.build = build
+	goSrc, hSrc, err := g.generate()
+	if err != nil {
+		// Keep the unformatted output around to find the problem.
+		if werr := os.WriteFile(filepath.Join(dir, out+".go"), goSrc, 0644); werr != nil {
+			return fmt.Errorf("%v (writing the unformatted output: %v)", err, werr)
+		}
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, out+".go"), goSrc, 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, out+".go.h"), hSrc, 0644); err != nil {
+		return err
+	}
+
+	if verbose {
+		for _, s := range g.skipped {
+			fmt.Fprintln(os.Stderr, "skipped", s)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "girgen: %d names left to hand-written code\n", len(g.skipped))
+	return nil
+}
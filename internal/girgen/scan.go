@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bufio"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// handWritten records what the hand-written files of the package already
+// provide. Generated code never redeclares any of it, which is how overrides
+// work: write the wrapper by hand and the generator leaves it alone.
+type handWritten struct {
+	types      map[string]bool // Go types
+	interfaces map[string]bool // Go types declared as interfaces
+	funcs      map[string]bool // Go functions, constants and variables
+	methods    map[string]bool // Go methods, as "Type.Method"
+	cRefs      map[string]bool // C identifiers referenced through C.name
+	skip       map[string]bool // C identifiers listed in the skip file
+}
+
+// scanPackage parses the Go files in dir that are built with tags, ignoring
+// generated ones.
+func scanPackage(dir string, tags []string) (*handWritten, error) {
+	hw := &handWritten{
+		types:      map[string]bool{},
+		interfaces: map[string]bool{},
+		funcs:      map[string]bool{},
+		methods:    map[string]bool{},
+		cRefs:      map[string]bool{},
+		skip:       map[string]bool{},
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	ctx := build.Default
+	ctx.BuildTags = tags
+	ctx.CgoEnabled = true
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if ok, err := ctx.MatchFile(dir, filepath.Base(name)); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if isGenerated(f) {
+			continue
+		}
+		hw.addFile(f)
+	}
+	return hw, nil
+}
+
+func (hw *handWritten) addFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				hw.funcs[d.Name.Name] = true
+				continue
+			}
+			hw.methods[receiverType(d.Recv.List[0].Type)+"."+d.Name.Name] = true
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					hw.types[s.Name.Name] = true
+					if _, ok := s.Type.(*ast.InterfaceType); ok {
+						hw.interfaces[s.Name.Name] = true
+					}
+				case *ast.ValueSpec:
+					for _, n := range s.Names {
+						hw.funcs[n.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == "C" {
+				hw.cRefs[sel.Sel.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+// isGenerated reports whether f carries the standard "Code generated ...
+// DO NOT EDIT." comment.
+func isGenerated(f *ast.File) bool {
+	for _, g := range f.Comments {
+		if g.Pos() > f.Package {
+			break
+		}
+		for _, c := range g.List {
+			if strings.HasPrefix(c.Text, "// Code generated ") && strings.HasSuffix(c.Text, " DO NOT EDIT.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// receiverType returns the name of the type in a method receiver.
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// readSkipFile adds the C identifiers listed in path, one per line, to the
+// skip list. Blank lines and lines starting with # are ignored. A missing
+// file is not an error.
+func (hw *handWritten) readSkipFile(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hw.skip[line] = true
+	}
+	return s.Err()
+}
+
+// covers reports whether the C function cname has already been wrapped by
+// hand or is to be skipped.
+func (hw *handWritten) covers(cname string) bool {
+	return hw.cRefs[cname] || hw.skip[cname]
+}
@@ -0,0 +1,11 @@
+//go:build !gtksourceview4
+// +build !gtksourceview4
+
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+
+func fakeLoaderCheck() {
+	C.gtk_source_fake_loader_check(nil, 0, nil)
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// externalClass describes a GObject type from another namespace that has a
+// gotk3 wrapper. Parent chains mirror the way gotk3 embeds its structs, so
+// the struct literal wrapping a *glib.Object can be derived from them.
+type externalClass struct {
+	goType  string // e.g. "gtk.TextView"
+	parent  string // GIR name of the embedded type, "" for glib.Object
+	cType   string
+	getType string
+}
+
+var externalClasses = map[string]externalClass{
+	"GObject.Object":           {"glib.Object", "", "GObject", "g_object_get_type"},
+	"GObject.InitiallyUnowned": {"glib.InitiallyUnowned", "GObject.Object", "GInitiallyUnowned", "g_initially_unowned_get_type"},
+	"Gio.Cancellable":          {"glib.Cancellable", "GObject.Object", "GCancellable", "g_cancellable_get_type"},
+	"Gio.File":                 {"glib.File", "GObject.Object", "GFile", "g_file_get_type"},
+	"Gio.Icon":                 {"glib.Icon", "GObject.Object", "GIcon", "g_icon_get_type"},
+	"GdkPixbuf.Pixbuf":         {"gdk.Pixbuf", "GObject.Object", "GdkPixbuf", "gdk_pixbuf_get_type"},
+	"Gtk.TextBuffer":           {"gtk.TextBuffer", "GObject.Object", "GtkTextBuffer", "gtk_text_buffer_get_type"},
+	"Gtk.TextMark":             {"gtk.TextMark", "GObject.Object", "GtkTextMark", "gtk_text_mark_get_type"},
+	"Gtk.TextTag":              {"gtk.TextTag", "GObject.Object", "GtkTextTag", "gtk_text_tag_get_type"},
+	"Gtk.Widget":               {"gtk.Widget", "GObject.InitiallyUnowned", "GtkWidget", "gtk_widget_get_type"},
+	"Gtk.Container":            {"gtk.Container", "Gtk.Widget", "GtkContainer", "gtk_container_get_type"},
+	"Gtk.Bin":                  {"gtk.Bin", "Gtk.Container", "GtkBin", "gtk_bin_get_type"},
+	"Gtk.Box":                  {"gtk.Box", "Gtk.Container", "GtkBox", "gtk_box_get_type"},
+	"Gtk.Window":               {"gtk.Window", "Gtk.Bin", "GtkWindow", "gtk_window_get_type"},
+	"Gtk.TextView":             {"gtk.TextView", "Gtk.Container", "GtkTextView", "gtk_text_view_get_type"},
+}
+
+// externalLiteral returns the struct literal of the gotk3 type name
+// wrapping obj.
+func (g *generator) externalLiteral(name, obj string) string {
+	ec := externalClasses[name]
+	if ec.parent == "" {
+		return obj
+	}
+	return ec.goType + "{" + g.externalLiteral(ec.parent, obj) + "}"
+}
+
+// basicTypes maps GIR fundamental types to Go types. The C conversion uses
+// the GIR name, which cgo knows from the glib headers.
+var basicTypes = map[string]string{
+	"gint":     "int",
+	"guint":    "uint",
+	"glong":    "int",
+	"gulong":   "uint",
+	"gint32":   "int32",
+	"guint32":  "uint32",
+	"gint64":   "int64",
+	"guint64":  "uint64",
+	"gsize":    "uint",
+	"gssize":   "int",
+	"gdouble":  "float64",
+	"gfloat":   "float32",
+	"gunichar": "rune",
+}
+
+// initialisms are spelled in upper case in Go names.
+var initialisms = map[string]string{
+	"id":   "ID",
+	"ids":  "IDs",
+	"uri":  "URI",
+	"uris": "URIs",
+	"url":  "URL",
+	"utf8": "UTF8",
+	"xml":  "XML",
+	"html": "HTML",
+	"ui":   "UI",
+	"rgba": "RGBA",
+}
+
+// camel converts a snake_case C name to an exported Go name.
+func camel(s string) string {
+	var b strings.Builder
+	for _, w := range strings.Split(s, "_") {
+		if w == "" {
+			continue
+		}
+		if u, ok := initialisms[w]; ok {
+			b.WriteString(u)
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+// paramName converts a C parameter name to a Go one that does not clash
+// with keywords or the names used in generated bodies.
+func paramName(s string) string {
+	name := camel(s)
+	if name == "" {
+		return "arg"
+	}
+	name = strings.ToLower(name[:1]) + name[1:]
+	switch {
+	case name == "type":
+		return "typ"
+	case token.Lookup(name).IsKeyword(), name == "v", name == "c", name == "err", name == "obj":
+		return name + "Arg"
+	}
+	return name
+}
+
+// param is the Go side of a C parameter.
+type param struct {
+	name   string
+	goType string
+	pre    []string // statements preparing the C argument
+	arg    string   // the C argument
+}
+
+// result is the Go side of a C return value.
+type result struct {
+	goType string
+	zero   string
+	conv   string // format converting the C value %s to goType
+	free   string // statement freeing c after conversion, if any
+	object bool   // a nil pointer is reported as errNilPtr
+}
+
+// isString reports whether t is a GIR string type.
+func isString(t *typeRef) bool {
+	return t != nil && (t.Name == "utf8" || t.Name == "filename")
+}
+
+// isGchar reports whether ctype is spelled with gchar rather than char, which
+// matters to cgo.
+func isGchar(ctype string) bool {
+	return strings.Contains(ctype, "gchar")
+}
+
+// mapParam maps an input parameter, or explains why it is not supported.
+func (g *generator) mapParam(p *parameter) (*param, error) {
+	if p.Varargs != nil {
+		return nil, fmt.Errorf("varargs")
+	}
+	if p.Direction != "" && p.Direction != "in" {
+		return nil, fmt.Errorf("%s parameter %s", p.Direction, p.Name)
+	}
+	if p.Array != nil || p.Type == nil {
+		return nil, fmt.Errorf("array parameter %s", p.Name)
+	}
+	if p.Transfer != "" && p.Transfer != "none" {
+		return nil, fmt.Errorf("parameter %s transfers ownership", p.Name)
+	}
+
+	t := p.Type
+	name := paramName(p.Name)
+	out := &param{name: name, arg: name}
+	switch {
+	case t.Name == "gboolean":
+		out.goType = "bool"
+		out.arg = "gbool(" + name + ")"
+	case basicTypes[t.Name] != "":
+		out.goType = basicTypes[t.Name]
+		out.arg = "C." + t.Name + "(" + name + ")"
+	case isString(t):
+		cname := "c" + camel(p.Name)
+		out.goType = "string"
+		if p.Nullable == "1" || p.AllowNone == "1" {
+			out.pre = []string{cname + " := cstringOrNil(" + name + ")"}
+			out.arg = cname
+			if !isGchar(t.CType) {
+				out.arg = "(*C.char)(" + cname + ")"
+			}
+		} else {
+			out.pre = []string{cname + " := C.CString(" + name + ")"}
+			out.arg = cname
+			if isGchar(t.CType) {
+				out.arg = "(*C.gchar)(" + cname + ")"
+			}
+		}
+		out.pre = append(out.pre, "defer C.free(unsafe.Pointer("+cname+"))")
+	case t.Name == "Gtk.TextIter":
+		out.goType = "*gtk.TextIter"
+		out.arg = "textIter(" + name + ")"
+	case g.enums[t.Name] != "":
+		out.goType = g.enums[t.Name]
+		out.arg = "C." + strings.TrimSuffix(t.CType, "*") + "(" + name + ")"
+		if strings.HasSuffix(t.CType, "*") {
+			return nil, fmt.Errorf("pointer to enum parameter %s", p.Name)
+		}
+	case g.locals[t.Name] != nil:
+		lt := g.locals[t.Name]
+		if err := g.usable(lt); err != nil {
+			return nil, err
+		}
+		out.goType = "*" + lt.goName
+		out.arg = name + ".native()"
+	case externalClasses[t.Name].goType != "":
+		ec := externalClasses[t.Name]
+		if t.Name == "GObject.Object" || t.Name == "GObject.InitiallyUnowned" {
+			return nil, fmt.Errorf("untyped object parameter %s", p.Name)
+		}
+		cname := "c" + camel(p.Name)
+		g.casts[ec.cType] = ec.getType
+		out.goType = "*" + ec.goType
+		out.pre = []string{
+			"var " + cname + " *C." + ec.cType,
+			"if " + name + " != nil {",
+			cname + " = C.to" + ec.cType + "(unsafe.Pointer(" + name + ".GObject))",
+			"}",
+		}
+		out.arg = cname
+	default:
+		return nil, fmt.Errorf("parameter %s of type %s", p.Name, t.Name)
+	}
+	return out, nil
+}
+
+// mapResult maps a return value, or explains why it is not supported. owner
+// is the type being constructed for constructors, nil otherwise.
+func (g *generator) mapResult(r *returnValue, owner *localType) (*result, error) {
+	if r.Array != nil {
+		return g.mapArrayResult(r)
+	}
+	t := r.Type
+	if t == nil || t.Name == "none" {
+		return nil, nil
+	}
+
+	if owner != nil {
+		return &result{
+			goType: "*" + owner.goName,
+			zero:   "nil",
+			conv:   "wrap" + owner.goName + "(" + g.ownership(r.Transfer, owner.floating, true) + ")",
+			object: true,
+		}, nil
+	}
+
+	switch {
+	case t.Name == "gboolean":
+		return &result{goType: "bool", zero: "false", conv: "gobool(%s)"}, nil
+	case basicTypes[t.Name] != "":
+		goType := basicTypes[t.Name]
+		return &result{goType: goType, zero: "0", conv: goType + "(%s)"}, nil
+	case isString(t):
+		res := &result{goType: "string", zero: `""`, conv: "goString(%s)"}
+		if !isGchar(t.CType) {
+			res.conv = "C.GoString(%s)"
+		}
+		if r.Transfer == "full" {
+			res.free = "C.g_free(C.gpointer(c))"
+		}
+		return res, nil
+	case g.enums[t.Name] != "":
+		goType := g.enums[t.Name]
+		return &result{goType: goType, zero: "0", conv: goType + "(%s)"}, nil
+	case g.locals[t.Name] != nil:
+		lt := g.locals[t.Name]
+		if err := g.usable(lt); err != nil {
+			return nil, err
+		}
+		return &result{
+			goType: "*" + lt.goName,
+			zero:   "nil",
+			conv:   "wrap" + lt.goName + "(" + g.ownership(r.Transfer, lt.floating, false) + ")",
+			object: true,
+		}, nil
+	case externalClasses[t.Name].goType != "":
+		ec := externalClasses[t.Name]
+		obj := g.ownership(r.Transfer, g.externalFloating(t.Name), false)
+		conv := obj
+		if ec.parent != "" {
+			conv = "&" + g.externalLiteral(t.Name, obj)
+		}
+		return &result{goType: "*" + ec.goType, zero: "nil", conv: conv, object: true}, nil
+	}
+	return nil, fmt.Errorf("return type %s", t.Name)
+}
+
+// mapArrayResult maps NULL-terminated string arrays, the only arrays
+// supported as return values.
+func (g *generator) mapArrayResult(r *returnValue) (*result, error) {
+	a := r.Array
+	if a.ZeroTerminated == "0" || a.Length != "" || !isString(a.Type) {
+		return nil, fmt.Errorf("array return value")
+	}
+
+	cstrs := "c"
+	if !isGchar(a.CType) {
+		cstrs = "(**C.gchar)(unsafe.Pointer(c))"
+	}
+	res := &result{goType: "[]string", zero: "nil", conv: "goStrings((**C.gchar)(unsafe.Pointer(%s)))"}
+	if isGchar(a.CType) {
+		res.conv = "goStrings(%s)"
+	}
+	switch r.Transfer {
+	case "full":
+		res.free = "C.g_strfreev(" + cstrs + ")"
+	case "container":
+		res.free = "C.g_free(C.gpointer(c))"
+	}
+	return res, nil
+}
+
+// ownership returns the format turning a C pointer into a *glib.Object.
+// Objects returned with transfer none are referenced with glib.Take. Full
+// transfers are adopted with glib.AssumeOwnership, except for freshly
+// constructed floating objects, whose floating reference glib.Take sinks.
+func (g *generator) ownership(transfer string, floating, constructor bool) string {
+	if transfer == "full" && !(floating && constructor) {
+		return "glib.AssumeOwnership(unsafe.Pointer(%s))"
+	}
+	return "glib.Take(unsafe.Pointer(%s))"
+}
+
+// externalFloating reports whether the external type name derives from
+// GInitiallyUnowned.
+func (g *generator) externalFloating(name string) bool {
+	for name != "" {
+		if name == "GObject.InitiallyUnowned" {
+			return true
+		}
+		name = externalClasses[name].parent
+	}
+	return false
+}
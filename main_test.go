@@ -0,0 +1,27 @@
+package sourceview
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// gtkInitialized is set by TestMain when GTK could be initialized.
+var gtkInitialized bool
+
+func TestMain(m *testing.M) {
+	if os.Getenv("DISPLAY") != "" {
+		gtkInitialized = gtk.InitCheck(nil) == nil
+	}
+	os.Exit(m.Run())
+}
+
+// requireGTK skips the test when GTK is not available, which is the case
+// without a display.
+func requireGTK(t *testing.T) {
+	t.Helper()
+	if !gtkInitialized {
+		t.Skip("GTK is not available; set DISPLAY to run this test")
+	}
+}
@@ -0,0 +1,101 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "map.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+	"github.com/gotk3/gotk3/pango"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_map_get_type()), marshalSourceMap},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceMap"] = wrapSourceMap
+}
+
+/*
+ * GtkSourceMap
+ */
+
+// SourceMap is a representation of GtkSourceMap, a zoomed-out overview of
+// the buffer of another SourceView.
+type SourceMap struct {
+	SourceView
+}
+
+// native returns a pointer to the underlying GtkSourceMap.
+func (v *SourceMap) native() *C.GtkSourceMap {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceMap(p)
+}
+
+func marshalSourceMap(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceMap(obj), nil
+}
+
+func wrapSourceMap(obj *glib.Object) *SourceMap {
+	return &SourceMap{*wrapSourceView(obj)}
+}
+
+// SourceMapNew is a wrapper around gtk_source_map_new().
+func SourceMapNew() (*SourceMap, error) {
+	c := C.gtk_source_map_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceMap(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetView is a wrapper around gtk_source_map_set_view().
+func (v *SourceMap) SetView(view *SourceView) {
+	C.gtk_source_map_set_view(v.native(), view.native())
+}
+
+// GetView is a wrapper around gtk_source_map_get_view().
+func (v *SourceMap) GetView() (*SourceView, error) {
+	c := C.gtk_source_map_get_view(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceView(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetFontDesc sets the "font-desc" property, the font used to draw the map.
+// The description is copied, so the caller keeps ownership of desc. A nil
+// desc restores the default font.
+func (v *SourceMap) SetFontDesc(desc *pango.FontDescription) {
+	var c *C.PangoFontDescription
+	if desc != nil {
+		c = (*C.PangoFontDescription)(unsafe.Pointer(desc.Native()))
+	}
+	C.source_map_set_font_desc(v.native(), c)
+}
+
+// GetFontDesc returns the "font-desc" property, or nil if the default font
+// is used. The result is a copy which is freed when it is garbage collected,
+// so the caller must not call Free on it.
+func (v *SourceMap) GetFontDesc() *pango.FontDescription {
+	c := C.source_map_get_font_desc(v.native())
+	if c == nil {
+		return nil
+	}
+	// pango.FontDescription has no exported constructor from a pointer; its
+	// only field is the native pointer.
+	desc := new(pango.FontDescription)
+	*(**C.PangoFontDescription)(unsafe.Pointer(desc)) = c
+	runtime.SetFinalizer(desc, func(*pango.FontDescription) { C.pango_font_description_free(c) })
+	return desc
+}
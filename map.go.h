@@ -0,0 +1,21 @@
+#include <gtk/gtk.h>
+
+static GtkSourceMap *
+toGtkSourceMap(void *p)
+{
+	return (GTK_SOURCE_MAP(p));
+}
+
+static void
+source_map_set_font_desc(GtkSourceMap *map, PangoFontDescription *desc)
+{
+	g_object_set(map, "font-desc", desc, NULL);
+}
+
+static PangoFontDescription *
+source_map_get_font_desc(GtkSourceMap *map)
+{
+	PangoFontDescription *desc = NULL;
+	g_object_get(map, "font-desc", &desc, NULL);
+	return desc;
+}
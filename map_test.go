@@ -0,0 +1,39 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/pango"
+)
+
+func TestSourceMap(t *testing.T) {
+	requireGTK(t)
+
+	sourceMap, err := SourceMapNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sourceMap.GetView(); err == nil {
+		t.Error("GetView() succeeded before SetView")
+	}
+	view, err := SourceViewNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sourceMap.SetView(view)
+	if got, err := sourceMap.GetView(); err != nil || got.Native() != view.Native() {
+		t.Errorf("GetView() = %v, %v, want the view set", got, err)
+	}
+
+	sourceMap.SetFontDesc(pango.FontDescriptionFromString("Monospace 3"))
+	desc := sourceMap.GetFontDesc()
+	if desc == nil {
+		t.Fatal("GetFontDesc() = nil after SetFontDesc")
+	}
+	if got := desc.GetFamily(); got != "Monospace" {
+		t.Errorf("GetFontDesc() family = %q, want %q", got, "Monospace")
+	}
+	if got := desc.GetSize(); got != 3*pango.SCALE {
+		t.Errorf("GetFontDesc() size = %d, want %d", got, 3*pango.SCALE)
+	}
+}
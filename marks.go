@@ -0,0 +1,342 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "marks.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_mark_get_type()), marshalSourceMark},
+		{glib.Type(C.gtk_source_mark_attributes_get_type()), marshalSourceMarkAttributes},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceMark"] = wrapSourceMark
+	gtk.WrapMap["GtkSourceMarkAttributes"] = wrapSourceMarkAttributes
+}
+
+/*
+ * GtkSourceMark
+ */
+
+// SourceMark is a representation of GtkSourceMark.
+type SourceMark struct {
+	gtk.TextMark
+}
+
+// native returns a pointer to the underlying GtkSourceMark.
+func (v *SourceMark) native() *C.GtkSourceMark {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceMark(p)
+}
+
+func marshalSourceMark(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceMark(obj), nil
+}
+
+func wrapSourceMark(obj *glib.Object) *SourceMark {
+	return &SourceMark{gtk.TextMark{obj}}
+}
+
+// markList converts a GSList of GtkSourceMark and frees the list.
+func markList(list *C.GSList) []*SourceMark {
+	var marks []*SourceMark
+	for l := list; l != nil; l = l.next {
+		marks = append(marks, wrapSourceMark(glib.Take(unsafe.Pointer(l.data))))
+	}
+	C.g_slist_free(list)
+	return marks
+}
+
+// SourceMarkNew is a wrapper around gtk_source_mark_new(). An empty name
+// creates an anonymous mark.
+func SourceMarkNew(name, category string) (*SourceMark, error) {
+	cname := cstringOrNil(name)
+	defer C.free(unsafe.Pointer(cname))
+	ccategory := C.CString(category)
+	defer C.free(unsafe.Pointer(ccategory))
+
+	c := C.gtk_source_mark_new(cname, (*C.gchar)(ccategory))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceMark(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetCategory is a wrapper around gtk_source_mark_get_category().
+func (v *SourceMark) GetCategory() string {
+	return goString(C.gtk_source_mark_get_category(v.native()))
+}
+
+// Next is a wrapper around gtk_source_mark_next(). An empty category matches
+// marks of any category.
+func (v *SourceMark) Next(category string) *SourceMark {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+
+	c := C.gtk_source_mark_next(v.native(), ccategory)
+	if c == nil {
+		return nil
+	}
+	return wrapSourceMark(glib.Take(unsafe.Pointer(c)))
+}
+
+// Prev is a wrapper around gtk_source_mark_prev(). An empty category matches
+// marks of any category.
+func (v *SourceMark) Prev(category string) *SourceMark {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+
+	c := C.gtk_source_mark_prev(v.native(), ccategory)
+	if c == nil {
+		return nil
+	}
+	return wrapSourceMark(glib.Take(unsafe.Pointer(c)))
+}
+
+// CreateSourceMark is a wrapper around gtk_source_buffer_create_source_mark().
+// An empty name creates an anonymous mark.
+func (v *SourceBuffer) CreateSourceMark(name, category string, where *gtk.TextIter) (*SourceMark, error) {
+	cname := cstringOrNil(name)
+	defer C.free(unsafe.Pointer(cname))
+	ccategory := C.CString(category)
+	defer C.free(unsafe.Pointer(ccategory))
+
+	c := C.gtk_source_buffer_create_source_mark(v.native(), cname, (*C.gchar)(ccategory), textIter(where))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceMark(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetSourceMarksAtLine is a wrapper around gtk_source_buffer_get_source_marks_at_line().
+// An empty category matches marks of any category.
+func (v *SourceBuffer) GetSourceMarksAtLine(line int, category string) []*SourceMark {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+	return markList(C.gtk_source_buffer_get_source_marks_at_line(v.native(), C.gint(line), ccategory))
+}
+
+// GetSourceMarksAtIter is a wrapper around gtk_source_buffer_get_source_marks_at_iter().
+// An empty category matches marks of any category.
+func (v *SourceBuffer) GetSourceMarksAtIter(iter *gtk.TextIter, category string) []*SourceMark {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+	return markList(C.gtk_source_buffer_get_source_marks_at_iter(v.native(), textIter(iter), ccategory))
+}
+
+// ForwardIterToSourceMark is a wrapper around gtk_source_buffer_forward_iter_to_source_mark().
+// An empty category matches marks of any category.
+func (v *SourceBuffer) ForwardIterToSourceMark(iter *gtk.TextIter, category string) bool {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+	return gobool(C.gtk_source_buffer_forward_iter_to_source_mark(v.native(), textIter(iter), ccategory))
+}
+
+// BackwardIterToSourceMark is a wrapper around gtk_source_buffer_backward_iter_to_source_mark().
+// An empty category matches marks of any category.
+func (v *SourceBuffer) BackwardIterToSourceMark(iter *gtk.TextIter, category string) bool {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+	return gobool(C.gtk_source_buffer_backward_iter_to_source_mark(v.native(), textIter(iter), ccategory))
+}
+
+// RemoveSourceMarks is a wrapper around gtk_source_buffer_remove_source_marks().
+// An empty category removes marks of any category.
+func (v *SourceBuffer) RemoveSourceMarks(start, end *gtk.TextIter, category string) {
+	ccategory := cstringOrNil(category)
+	defer C.free(unsafe.Pointer(ccategory))
+	C.gtk_source_buffer_remove_source_marks(v.native(), textIter(start), textIter(end), ccategory)
+}
+
+/*
+ * GtkSourceMarkAttributes
+ */
+
+// SourceMarkAttributes is a representation of GtkSourceMarkAttributes.
+type SourceMarkAttributes struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceMarkAttributes.
+func (v *SourceMarkAttributes) native() *C.GtkSourceMarkAttributes {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceMarkAttributes(p)
+}
+
+func marshalSourceMarkAttributes(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceMarkAttributes(obj), nil
+}
+
+func wrapSourceMarkAttributes(obj *glib.Object) *SourceMarkAttributes {
+	return &SourceMarkAttributes{obj}
+}
+
+// SourceMarkAttributesNew is a wrapper around gtk_source_mark_attributes_new().
+func SourceMarkAttributesNew() (*SourceMarkAttributes, error) {
+	c := C.gtk_source_mark_attributes_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceMarkAttributes(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SetBackground is a wrapper around gtk_source_mark_attributes_set_background().
+func (v *SourceMarkAttributes) SetBackground(background *gdk.RGBA) {
+	C.gtk_source_mark_attributes_set_background(v.native(), (*C.GdkRGBA)(unsafe.Pointer(background.Native())))
+}
+
+// GetBackground is a wrapper around gtk_source_mark_attributes_get_background().
+// It returns false if no background color is set.
+func (v *SourceMarkAttributes) GetBackground() (*gdk.RGBA, bool) {
+	rgba := new(C.GdkRGBA)
+	ok := C.gtk_source_mark_attributes_get_background(v.native(), rgba)
+	return gdk.WrapRGBA(unsafe.Pointer(rgba)), gobool(ok)
+}
+
+// SetIconName is a wrapper around gtk_source_mark_attributes_set_icon_name().
+func (v *SourceMarkAttributes) SetIconName(iconName string) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_mark_attributes_set_icon_name(v.native(), (*C.gchar)(cstr))
+}
+
+// GetIconName is a wrapper around gtk_source_mark_attributes_get_icon_name().
+func (v *SourceMarkAttributes) GetIconName() string {
+	c := C.gtk_source_mark_attributes_get_icon_name(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// SetGIcon is a wrapper around gtk_source_mark_attributes_set_gicon().
+// Passing nil clears the icon.
+func (v *SourceMarkAttributes) SetGIcon(icon *glib.Icon) {
+	var c *C.GIcon
+	if icon != nil {
+		c = (*C.GIcon)(unsafe.Pointer(icon.Native()))
+	}
+	C.gtk_source_mark_attributes_set_gicon(v.native(), c)
+}
+
+// GetGIcon is a wrapper around gtk_source_mark_attributes_get_gicon().
+func (v *SourceMarkAttributes) GetGIcon() *glib.Icon {
+	c := C.gtk_source_mark_attributes_get_gicon(v.native())
+	if c == nil {
+		return nil
+	}
+	return &glib.Icon{glib.Take(unsafe.Pointer(c))}
+}
+
+// SetPixbuf is a wrapper around gtk_source_mark_attributes_set_pixbuf().
+// Passing nil clears the pixbuf.
+func (v *SourceMarkAttributes) SetPixbuf(pixbuf *gdk.Pixbuf) {
+	var c *C.GdkPixbuf
+	if pixbuf != nil {
+		c = (*C.GdkPixbuf)(unsafe.Pointer(pixbuf.Native()))
+	}
+	C.gtk_source_mark_attributes_set_pixbuf(v.native(), c)
+}
+
+// GetPixbuf is a wrapper around gtk_source_mark_attributes_get_pixbuf().
+func (v *SourceMarkAttributes) GetPixbuf() *gdk.Pixbuf {
+	c := C.gtk_source_mark_attributes_get_pixbuf(v.native())
+	if c == nil {
+		return nil
+	}
+	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}
+}
+
+// RenderIcon is a wrapper around gtk_source_mark_attributes_render_icon().
+func (v *SourceMarkAttributes) RenderIcon(widget gtk.IWidget, size int) *gdk.Pixbuf {
+	cwidget := C.toGtkWidget(unsafe.Pointer(widget.ToWidget().GObject))
+	c := C.gtk_source_mark_attributes_render_icon(v.native(), cwidget, C.gint(size))
+	if c == nil {
+		return nil
+	}
+	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}
+}
+
+// GetTooltipText is a wrapper around gtk_source_mark_attributes_get_tooltip_text().
+func (v *SourceMarkAttributes) GetTooltipText(mark *SourceMark) string {
+	c := C.gtk_source_mark_attributes_get_tooltip_text(v.native(), mark.native())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetTooltipMarkup is a wrapper around gtk_source_mark_attributes_get_tooltip_markup().
+func (v *SourceMarkAttributes) GetTooltipMarkup(mark *SourceMark) string {
+	c := C.gtk_source_mark_attributes_get_tooltip_markup(v.native(), mark.native())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// OnQueryTooltipText connects f to the "query-tooltip-text" signal, which
+// asks for the tooltip text of a mark in the gutter.
+func (v *SourceMarkAttributes) OnQueryTooltipText(f func(mark *SourceMark) string) glib.SignalHandle {
+	return v.Connect("query-tooltip-text", func(_ interface{}, mark *SourceMark) string {
+		return f(mark)
+	})
+}
+
+// OnQueryTooltipMarkup connects f to the "query-tooltip-markup" signal, which
+// asks for the tooltip markup of a mark in the gutter.
+func (v *SourceMarkAttributes) OnQueryTooltipMarkup(f func(mark *SourceMark) string) glib.SignalHandle {
+	return v.Connect("query-tooltip-markup", func(_ interface{}, mark *SourceMark) string {
+		return f(mark)
+	})
+}
+
+// SetMarkAttributes is a wrapper around gtk_source_view_set_mark_attributes().
+func (v *SourceView) SetMarkAttributes(category string, attributes *SourceMarkAttributes, priority int) {
+	cstr := C.CString(category)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_view_set_mark_attributes(v.native(), (*C.gchar)(cstr), attributes.native(), C.gint(priority))
+}
+
+// GetMarkAttributes is a wrapper around gtk_source_view_get_mark_attributes().
+// It also returns the priority of the category.
+func (v *SourceView) GetMarkAttributes(category string) (*SourceMarkAttributes, int) {
+	cstr := C.CString(category)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var priority C.gint
+	c := C.gtk_source_view_get_mark_attributes(v.native(), (*C.gchar)(cstr), &priority)
+	if c == nil {
+		return nil, 0
+	}
+	return wrapSourceMarkAttributes(glib.Take(unsafe.Pointer(c))), int(priority)
+}
+
+// OnLineMarkActivated connects f to the "line-mark-activated" signal, which
+// is emitted when the user clicks in the line marks gutter.
+func (v *SourceView) OnLineMarkActivated(f func(iter *gtk.TextIter, event *gdk.Event)) glib.SignalHandle {
+	return v.Connect("line-mark-activated", func(_ interface{}, iter *gtk.TextIter, event *gdk.Event) {
+		f(iter, event)
+	})
+}
@@ -0,0 +1,19 @@
+#include <gtk/gtk.h>
+
+static GtkSourceMark *
+toGtkSourceMark(void *p)
+{
+	return (GTK_SOURCE_MARK(p));
+}
+
+static GtkSourceMarkAttributes *
+toGtkSourceMarkAttributes(void *p)
+{
+	return (GTK_SOURCE_MARK_ATTRIBUTES(p));
+}
+
+static GtkWidget *
+toGtkWidget(void *p)
+{
+	return (GTK_WIDGET(p));
+}
@@ -0,0 +1,161 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+)
+
+func TestSourceMarks(t *testing.T) {
+	requireGTK(t)
+	buffer := newTestBuffer(t, "zero\none\ntwo\n")
+
+	first, err := buffer.CreateSourceMark("first", "bookmark", buffer.GetIterAtLine(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := buffer.CreateSourceMark("", "error", buffer.GetIterAtLine(1)); err != nil {
+		t.Fatal(err)
+	}
+	last, err := buffer.CreateSourceMark("last", "bookmark", buffer.GetIterAtLine(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := first.GetName(); got != "first" {
+		t.Errorf("GetName() = %q, want %q", got, "first")
+	}
+	if got := first.GetCategory(); got != "bookmark" {
+		t.Errorf("GetCategory() = %q, want %q", got, "bookmark")
+	}
+
+	if next := first.Next("bookmark"); next == nil || next.Native() != last.Native() {
+		t.Errorf("Next(\"bookmark\") = %v, want the mark on line 2", next)
+	}
+	if next := first.Next(""); next == nil || next.GetCategory() != "error" {
+		t.Errorf("Next(\"\") = %v, want the error mark on line 1", next)
+	}
+	if prev := last.Prev("bookmark"); prev == nil || prev.Native() != first.Native() {
+		t.Errorf("Prev(\"bookmark\") = %v, want the mark on line 0", prev)
+	}
+	if prev := first.Prev(""); prev != nil {
+		t.Errorf("Prev(\"\") = %v for the first mark, want nil", prev)
+	}
+
+	if marks := buffer.GetSourceMarksAtLine(1, ""); len(marks) != 1 || marks[0].GetCategory() != "error" {
+		t.Errorf("GetSourceMarksAtLine(1, \"\") = %v, want the error mark", marks)
+	}
+	if marks := buffer.GetSourceMarksAtIter(buffer.GetIterAtLine(2), "error"); len(marks) != 0 {
+		t.Errorf("GetSourceMarksAtIter() = %v for another category, want none", marks)
+	}
+
+	iter := buffer.GetStartIter()
+	if !buffer.ForwardIterToSourceMark(iter, "error") || iter.GetLine() != 1 {
+		t.Errorf("ForwardIterToSourceMark() moved to line %d, want 1", iter.GetLine())
+	}
+	iter = buffer.GetEndIter()
+	if !buffer.BackwardIterToSourceMark(iter, "bookmark") || iter.GetLine() != 2 {
+		t.Errorf("BackwardIterToSourceMark() moved to line %d, want 2", iter.GetLine())
+	}
+
+	start, end := buffer.GetBounds()
+	buffer.RemoveSourceMarks(start, end, "bookmark")
+	if marks := buffer.GetSourceMarksAtLine(0, "bookmark"); len(marks) != 0 {
+		t.Errorf("GetSourceMarksAtLine(0) = %v after RemoveSourceMarks, want none", marks)
+	}
+	if marks := buffer.GetSourceMarksAtLine(1, "error"); len(marks) != 1 {
+		t.Errorf("RemoveSourceMarks() removed marks of another category, %d left", len(marks))
+	}
+}
+
+func TestSourceMarkNew(t *testing.T) {
+	requireGTK(t)
+
+	mark, err := SourceMarkNew("", "warning")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mark.GetCategory(); got != "warning" {
+		t.Errorf("GetCategory() = %q, want %q", got, "warning")
+	}
+	if got := mark.GetName(); got != "" {
+		t.Errorf("GetName() = %q for an anonymous mark, want \"\"", got)
+	}
+}
+
+func TestSourceMarkAttributes(t *testing.T) {
+	requireGTK(t)
+
+	attrs, err := SourceMarkAttributesNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := attrs.GetBackground(); ok {
+		t.Error("GetBackground() ok = true before SetBackground")
+	}
+	attrs.SetBackground(gdk.NewRGBA(1, 0, 0, 1))
+	if rgba, ok := attrs.GetBackground(); !ok || rgba.GetRed() != 1 || rgba.GetGreen() != 0 {
+		t.Errorf("GetBackground() = %v, %v, want red", rgba, ok)
+	}
+
+	attrs.SetIconName("dialog-warning")
+	if got := attrs.GetIconName(); got != "dialog-warning" {
+		t.Errorf("GetIconName() = %q, want %q", got, "dialog-warning")
+	}
+
+	icon, err := glib.IconNewForString("dialog-error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attrs.SetGIcon(icon)
+	if got := attrs.GetGIcon(); got == nil || got.Native() != icon.Native() {
+		t.Errorf("GetGIcon() = %v, want the icon set", got)
+	}
+	attrs.SetGIcon(nil)
+	if got := attrs.GetGIcon(); got != nil {
+		t.Errorf("GetGIcon() = %v after SetGIcon(nil), want nil", got)
+	}
+
+	pixbuf, err := gdk.PixbufNew(gdk.COLORSPACE_RGB, true, 8, 16, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attrs.SetPixbuf(pixbuf)
+	if got := attrs.GetPixbuf(); got == nil || got.Native() != pixbuf.Native() {
+		t.Errorf("GetPixbuf() = %v, want the pixbuf set", got)
+	}
+	attrs.SetPixbuf(nil)
+	if got := attrs.GetPixbuf(); got != nil {
+		t.Errorf("GetPixbuf() = %v after SetPixbuf(nil), want nil", got)
+	}
+
+	mark, err := SourceMarkNew("", "bookmark")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attrs.OnQueryTooltipText(func(mark *SourceMark) string {
+		return "text for " + mark.GetCategory()
+	})
+	attrs.OnQueryTooltipMarkup(func(mark *SourceMark) string {
+		return "<b>" + mark.GetCategory() + "</b>"
+	})
+	if got := attrs.GetTooltipText(mark); got != "text for bookmark" {
+		t.Errorf("GetTooltipText() = %q, want %q", got, "text for bookmark")
+	}
+	if got := attrs.GetTooltipMarkup(mark); got != "<b>bookmark</b>" {
+		t.Errorf("GetTooltipMarkup() = %q, want %q", got, "<b>bookmark</b>")
+	}
+
+	view, err := SourceViewNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	view.SetMarkAttributes("bookmark", attrs, 7)
+	got, priority := view.GetMarkAttributes("bookmark")
+	if got == nil || got.Native() != attrs.Native() || priority != 7 {
+		t.Errorf("GetMarkAttributes() = %v, %d, want the attributes set with priority 7", got, priority)
+	}
+	if got, _ := view.GetMarkAttributes("unknown"); got != nil {
+		t.Errorf("GetMarkAttributes(\"unknown\") = %v, want nil", got)
+	}
+}
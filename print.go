@@ -0,0 +1,302 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "print.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_print_compositor_get_type()), marshalSourcePrintCompositor},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourcePrintCompositor"] = wrapSourcePrintCompositor
+}
+
+func printContext(context *gtk.PrintContext) *C.GtkPrintContext {
+	return C.toGtkPrintContext(unsafe.Pointer(context.GObject))
+}
+
+/*
+ * GtkSourcePrintCompositor
+ */
+
+// SourcePrintCompositor is a representation of GtkSourcePrintCompositor.
+type SourcePrintCompositor struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourcePrintCompositor.
+func (v *SourcePrintCompositor) native() *C.GtkSourcePrintCompositor {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourcePrintCompositor(p)
+}
+
+func marshalSourcePrintCompositor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourcePrintCompositor(obj), nil
+}
+
+func wrapSourcePrintCompositor(obj *glib.Object) *SourcePrintCompositor {
+	return &SourcePrintCompositor{obj}
+}
+
+// SourcePrintCompositorNew is a wrapper around gtk_source_print_compositor_new().
+func SourcePrintCompositorNew(buffer *SourceBuffer) (*SourcePrintCompositor, error) {
+	c := C.gtk_source_print_compositor_new(buffer.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourcePrintCompositor(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SourcePrintCompositorNewFromView is a wrapper around gtk_source_print_compositor_new_from_view().
+func SourcePrintCompositorNewFromView(view *SourceView) (*SourcePrintCompositor, error) {
+	c := C.gtk_source_print_compositor_new_from_view(view.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourcePrintCompositor(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetBuffer is a wrapper around gtk_source_print_compositor_get_buffer().
+func (v *SourcePrintCompositor) GetBuffer() (*SourceBuffer, error) {
+	c := C.gtk_source_print_compositor_get_buffer(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceBuffer(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetTabWidth is a wrapper around gtk_source_print_compositor_set_tab_width().
+func (v *SourcePrintCompositor) SetTabWidth(width uint) {
+	C.gtk_source_print_compositor_set_tab_width(v.native(), C.guint(width))
+}
+
+// GetTabWidth is a wrapper around gtk_source_print_compositor_get_tab_width().
+func (v *SourcePrintCompositor) GetTabWidth() uint {
+	return uint(C.gtk_source_print_compositor_get_tab_width(v.native()))
+}
+
+// SetWrapMode is a wrapper around gtk_source_print_compositor_set_wrap_mode().
+func (v *SourcePrintCompositor) SetWrapMode(mode gtk.WrapMode) {
+	C.gtk_source_print_compositor_set_wrap_mode(v.native(), C.GtkWrapMode(mode))
+}
+
+// GetWrapMode is a wrapper around gtk_source_print_compositor_get_wrap_mode().
+func (v *SourcePrintCompositor) GetWrapMode() gtk.WrapMode {
+	return gtk.WrapMode(C.gtk_source_print_compositor_get_wrap_mode(v.native()))
+}
+
+// SetHighlightSyntax is a wrapper around gtk_source_print_compositor_set_highlight_syntax().
+func (v *SourcePrintCompositor) SetHighlightSyntax(highlight bool) {
+	C.gtk_source_print_compositor_set_highlight_syntax(v.native(), gbool(highlight))
+}
+
+// GetHighlightSyntax is a wrapper around gtk_source_print_compositor_get_highlight_syntax().
+func (v *SourcePrintCompositor) GetHighlightSyntax() bool {
+	return gobool(C.gtk_source_print_compositor_get_highlight_syntax(v.native()))
+}
+
+// SetPrintLineNumbers is a wrapper around gtk_source_print_compositor_set_print_line_numbers().
+// Line numbers are printed every interval lines; 0 disables them.
+func (v *SourcePrintCompositor) SetPrintLineNumbers(interval uint) {
+	C.gtk_source_print_compositor_set_print_line_numbers(v.native(), C.guint(interval))
+}
+
+// GetPrintLineNumbers is a wrapper around gtk_source_print_compositor_get_print_line_numbers().
+func (v *SourcePrintCompositor) GetPrintLineNumbers() uint {
+	return uint(C.gtk_source_print_compositor_get_print_line_numbers(v.native()))
+}
+
+// SetBodyFontName is a wrapper around gtk_source_print_compositor_set_body_font_name().
+func (v *SourcePrintCompositor) SetBodyFontName(fontName string) {
+	cstr := C.CString(fontName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_print_compositor_set_body_font_name(v.native(), (*C.gchar)(cstr))
+}
+
+// GetBodyFontName is a wrapper around gtk_source_print_compositor_get_body_font_name().
+func (v *SourcePrintCompositor) GetBodyFontName() string {
+	c := C.gtk_source_print_compositor_get_body_font_name(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// SetLineNumbersFontName is a wrapper around gtk_source_print_compositor_set_line_numbers_font_name().
+// An empty name uses the body font.
+func (v *SourcePrintCompositor) SetLineNumbersFontName(fontName string) {
+	cstr := cstringOrNil(fontName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_print_compositor_set_line_numbers_font_name(v.native(), cstr)
+}
+
+// GetLineNumbersFontName is a wrapper around gtk_source_print_compositor_get_line_numbers_font_name().
+func (v *SourcePrintCompositor) GetLineNumbersFontName() string {
+	c := C.gtk_source_print_compositor_get_line_numbers_font_name(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// SetHeaderFontName is a wrapper around gtk_source_print_compositor_set_header_font_name().
+// An empty name uses the body font.
+func (v *SourcePrintCompositor) SetHeaderFontName(fontName string) {
+	cstr := cstringOrNil(fontName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_print_compositor_set_header_font_name(v.native(), cstr)
+}
+
+// GetHeaderFontName is a wrapper around gtk_source_print_compositor_get_header_font_name().
+func (v *SourcePrintCompositor) GetHeaderFontName() string {
+	c := C.gtk_source_print_compositor_get_header_font_name(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// SetFooterFontName is a wrapper around gtk_source_print_compositor_set_footer_font_name().
+// An empty name uses the body font.
+func (v *SourcePrintCompositor) SetFooterFontName(fontName string) {
+	cstr := cstringOrNil(fontName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_print_compositor_set_footer_font_name(v.native(), cstr)
+}
+
+// GetFooterFontName is a wrapper around gtk_source_print_compositor_get_footer_font_name().
+func (v *SourcePrintCompositor) GetFooterFontName() string {
+	c := C.gtk_source_print_compositor_get_footer_font_name(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// SetTopMargin is a wrapper around gtk_source_print_compositor_set_top_margin().
+func (v *SourcePrintCompositor) SetTopMargin(margin float64, unit gtk.Unit) {
+	C.gtk_source_print_compositor_set_top_margin(v.native(), C.gdouble(margin), C.GtkUnit(unit))
+}
+
+// GetTopMargin is a wrapper around gtk_source_print_compositor_get_top_margin().
+func (v *SourcePrintCompositor) GetTopMargin(unit gtk.Unit) float64 {
+	return float64(C.gtk_source_print_compositor_get_top_margin(v.native(), C.GtkUnit(unit)))
+}
+
+// SetBottomMargin is a wrapper around gtk_source_print_compositor_set_bottom_margin().
+func (v *SourcePrintCompositor) SetBottomMargin(margin float64, unit gtk.Unit) {
+	C.gtk_source_print_compositor_set_bottom_margin(v.native(), C.gdouble(margin), C.GtkUnit(unit))
+}
+
+// GetBottomMargin is a wrapper around gtk_source_print_compositor_get_bottom_margin().
+func (v *SourcePrintCompositor) GetBottomMargin(unit gtk.Unit) float64 {
+	return float64(C.gtk_source_print_compositor_get_bottom_margin(v.native(), C.GtkUnit(unit)))
+}
+
+// SetLeftMargin is a wrapper around gtk_source_print_compositor_set_left_margin().
+func (v *SourcePrintCompositor) SetLeftMargin(margin float64, unit gtk.Unit) {
+	C.gtk_source_print_compositor_set_left_margin(v.native(), C.gdouble(margin), C.GtkUnit(unit))
+}
+
+// GetLeftMargin is a wrapper around gtk_source_print_compositor_get_left_margin().
+func (v *SourcePrintCompositor) GetLeftMargin(unit gtk.Unit) float64 {
+	return float64(C.gtk_source_print_compositor_get_left_margin(v.native(), C.GtkUnit(unit)))
+}
+
+// SetRightMargin is a wrapper around gtk_source_print_compositor_set_right_margin().
+func (v *SourcePrintCompositor) SetRightMargin(margin float64, unit gtk.Unit) {
+	C.gtk_source_print_compositor_set_right_margin(v.native(), C.gdouble(margin), C.GtkUnit(unit))
+}
+
+// GetRightMargin is a wrapper around gtk_source_print_compositor_get_right_margin().
+func (v *SourcePrintCompositor) GetRightMargin(unit gtk.Unit) float64 {
+	return float64(C.gtk_source_print_compositor_get_right_margin(v.native(), C.GtkUnit(unit)))
+}
+
+// SetPrintHeader is a wrapper around gtk_source_print_compositor_set_print_header().
+func (v *SourcePrintCompositor) SetPrintHeader(print bool) {
+	C.gtk_source_print_compositor_set_print_header(v.native(), gbool(print))
+}
+
+// GetPrintHeader is a wrapper around gtk_source_print_compositor_get_print_header().
+func (v *SourcePrintCompositor) GetPrintHeader() bool {
+	return gobool(C.gtk_source_print_compositor_get_print_header(v.native()))
+}
+
+// SetPrintFooter is a wrapper around gtk_source_print_compositor_set_print_footer().
+func (v *SourcePrintCompositor) SetPrintFooter(print bool) {
+	C.gtk_source_print_compositor_set_print_footer(v.native(), gbool(print))
+}
+
+// GetPrintFooter is a wrapper around gtk_source_print_compositor_get_print_footer().
+func (v *SourcePrintCompositor) GetPrintFooter() bool {
+	return gobool(C.gtk_source_print_compositor_get_print_footer(v.native()))
+}
+
+// SetHeaderFormat is a wrapper around gtk_source_print_compositor_set_header_format().
+// The formats are strftime-style strings where %N is the page number and %Q
+// the page count; an empty format leaves that part of the header blank.
+func (v *SourcePrintCompositor) SetHeaderFormat(separator bool, left, center, right string) {
+	cleft, ccenter, cright := cstringOrNil(left), cstringOrNil(center), cstringOrNil(right)
+	defer C.free(unsafe.Pointer(cleft))
+	defer C.free(unsafe.Pointer(ccenter))
+	defer C.free(unsafe.Pointer(cright))
+	C.gtk_source_print_compositor_set_header_format(v.native(), gbool(separator), cleft, ccenter, cright)
+}
+
+// SetFooterFormat is a wrapper around gtk_source_print_compositor_set_footer_format().
+// See SetHeaderFormat for the format syntax.
+func (v *SourcePrintCompositor) SetFooterFormat(separator bool, left, center, right string) {
+	cleft, ccenter, cright := cstringOrNil(left), cstringOrNil(center), cstringOrNil(right)
+	defer C.free(unsafe.Pointer(cleft))
+	defer C.free(unsafe.Pointer(ccenter))
+	defer C.free(unsafe.Pointer(cright))
+	C.gtk_source_print_compositor_set_footer_format(v.native(), gbool(separator), cleft, ccenter, cright)
+}
+
+// GetNPages is a wrapper around gtk_source_print_compositor_get_n_pages().
+// It returns -1 until pagination has finished.
+func (v *SourcePrintCompositor) GetNPages() int {
+	return int(C.gtk_source_print_compositor_get_n_pages(v.native()))
+}
+
+// Paginate is a wrapper around gtk_source_print_compositor_paginate().
+// It returns true once the whole document has been paginated.
+func (v *SourcePrintCompositor) Paginate(context *gtk.PrintContext) bool {
+	return gobool(C.gtk_source_print_compositor_paginate(v.native(), printContext(context)))
+}
+
+// GetPaginationProgress is a wrapper around gtk_source_print_compositor_get_pagination_progress().
+func (v *SourcePrintCompositor) GetPaginationProgress() float64 {
+	return float64(C.gtk_source_print_compositor_get_pagination_progress(v.native()))
+}
+
+// DrawPage is a wrapper around gtk_source_print_compositor_draw_page().
+func (v *SourcePrintCompositor) DrawPage(context *gtk.PrintContext, pageNr int) {
+	C.gtk_source_print_compositor_draw_page(v.native(), printContext(context), C.gint(pageNr))
+}
+
+// ExportPDF renders the whole buffer to a PDF file without showing a print
+// dialog. It paginates the buffer and draws each page onto a cairo PDF
+// surface for filename, and returns once the file has been written.
+func (v *SourcePrintCompositor) ExportPDF(filename string) error {
+	cfilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cfilename))
+
+	var err *C.GError
+	status := C.print_compositor_export_pdf(v.native(), cfilename, &err)
+	if err != nil {
+		return goError(err)
+	}
+	if status != C.CAIRO_STATUS_SUCCESS {
+		return errors.New(C.GoString(C.cairo_status_to_string(status)))
+	}
+	return nil
+}
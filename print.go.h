@@ -0,0 +1,71 @@
+#include <gtk/gtk.h>
+#include <cairo-pdf.h>
+
+static GtkSourcePrintCompositor *
+toGtkSourcePrintCompositor(void *p)
+{
+	return (GTK_SOURCE_PRINT_COMPOSITOR(p));
+}
+
+static GtkPrintContext *
+toGtkPrintContext(void *p)
+{
+	return (GTK_PRINT_CONTEXT(p));
+}
+
+typedef struct {
+	GtkSourcePrintCompositor *compositor;
+	const char *filename;
+	gboolean rendered;
+	cairo_status_t status;
+} ExportPDFData;
+
+static gboolean
+export_pdf_preview(GtkPrintOperation *op, GtkPrintOperationPreview *preview,
+                   GtkPrintContext *context, GtkWindow *parent, gpointer user_data)
+{
+	ExportPDFData *data = user_data;
+	GtkPageSetup *setup = gtk_print_context_get_page_setup(context);
+	cairo_surface_t *surface;
+	cairo_t *cr;
+	gint i, n;
+
+	surface = cairo_pdf_surface_create(data->filename,
+	                                   gtk_page_setup_get_paper_width(setup, GTK_UNIT_POINTS),
+	                                   gtk_page_setup_get_paper_height(setup, GTK_UNIT_POINTS));
+	cr = cairo_create(surface);
+	gtk_print_context_set_cairo_context(context, cr, 72, 72);
+
+	while (!gtk_source_print_compositor_paginate(data->compositor, context))
+		;
+	n = gtk_source_print_compositor_get_n_pages(data->compositor);
+	for (i = 0; i < n; i++) {
+		gtk_source_print_compositor_draw_page(data->compositor, context, i);
+		cairo_show_page(cr);
+	}
+
+	cairo_destroy(cr);
+	cairo_surface_finish(surface);
+	data->status = cairo_surface_status(surface);
+	cairo_surface_destroy(surface);
+	data->rendered = TRUE;
+	return TRUE;
+}
+
+// print_compositor_export_pdf draws every page of compositor onto a cairo
+// PDF surface for filename. GTK 3 has no public constructor for
+// GtkPrintContext, so the context comes from a preview run of a print
+// operation that never shows any UI; the pages themselves are drawn here.
+static cairo_status_t
+print_compositor_export_pdf(GtkSourcePrintCompositor *compositor, const char *filename, GError **error)
+{
+	ExportPDFData data = { compositor, filename, FALSE, CAIRO_STATUS_SUCCESS };
+	GtkPrintOperation *op = gtk_print_operation_new();
+
+	g_signal_connect(op, "preview", G_CALLBACK(export_pdf_preview), &data);
+	gtk_print_operation_run(op, GTK_PRINT_OPERATION_ACTION_PREVIEW, NULL, error);
+	if (data.rendered)
+		gtk_print_operation_preview_end_preview(GTK_PRINT_OPERATION_PREVIEW(op));
+	g_object_unref(op);
+	return data.status;
+}
@@ -0,0 +1,132 @@
+package sourceview
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func TestSourcePrintCompositorSettings(t *testing.T) {
+	requireGTK(t)
+	buffer := newTestBuffer(t, "int x;\n")
+
+	compositor, err := SourcePrintCompositorNew(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := compositor.GetBuffer(); err != nil || got.Native() != buffer.Native() {
+		t.Errorf("GetBuffer() = %v, %v, want the printed buffer", got, err)
+	}
+
+	compositor.SetTabWidth(3)
+	if got := compositor.GetTabWidth(); got != 3 {
+		t.Errorf("GetTabWidth() = %d, want 3", got)
+	}
+	compositor.SetWrapMode(gtk.WRAP_WORD)
+	if got := compositor.GetWrapMode(); got != gtk.WRAP_WORD {
+		t.Errorf("GetWrapMode() = %v, want WRAP_WORD", got)
+	}
+	compositor.SetHighlightSyntax(false)
+	if compositor.GetHighlightSyntax() {
+		t.Error("GetHighlightSyntax() = true after SetHighlightSyntax(false)")
+	}
+	compositor.SetPrintLineNumbers(5)
+	if got := compositor.GetPrintLineNumbers(); got != 5 {
+		t.Errorf("GetPrintLineNumbers() = %d, want 5", got)
+	}
+	compositor.SetPrintHeader(true)
+	compositor.SetPrintFooter(true)
+	if !compositor.GetPrintHeader() || !compositor.GetPrintFooter() {
+		t.Errorf("GetPrintHeader(), GetPrintFooter() = %v, %v, want true, true",
+			compositor.GetPrintHeader(), compositor.GetPrintFooter())
+	}
+	compositor.SetHeaderFormat(true, "left", "%N", "right")
+	compositor.SetFooterFormat(false, "", "page %N of %Q", "")
+
+	fonts := []struct {
+		name string
+		set  func(string)
+		get  func() string
+	}{
+		{"body", compositor.SetBodyFontName, compositor.GetBodyFontName},
+		{"line numbers", compositor.SetLineNumbersFontName, compositor.GetLineNumbersFontName},
+		{"header", compositor.SetHeaderFontName, compositor.GetHeaderFontName},
+		{"footer", compositor.SetFooterFontName, compositor.GetFooterFontName},
+	}
+	for _, font := range fonts {
+		font.set("Monospace 7")
+		if got := font.get(); got != "Monospace 7" {
+			t.Errorf("%s font name = %q, want %q", font.name, got, "Monospace 7")
+		}
+	}
+
+	margins := []struct {
+		name string
+		set  func(float64, gtk.Unit)
+		get  func(gtk.Unit) float64
+	}{
+		{"top", compositor.SetTopMargin, compositor.GetTopMargin},
+		{"bottom", compositor.SetBottomMargin, compositor.GetBottomMargin},
+		{"left", compositor.SetLeftMargin, compositor.GetLeftMargin},
+		{"right", compositor.SetRightMargin, compositor.GetRightMargin},
+	}
+	for _, margin := range margins {
+		margin.set(1, gtk.GTK_UNIT_INCH)
+		if got := margin.get(gtk.GTK_UNIT_POINTS); math.Abs(got-72) > 1e-6 {
+			t.Errorf("%s margin = %v points, want 72 for one inch", margin.name, got)
+		}
+	}
+}
+
+func TestSourcePrintCompositorNewFromView(t *testing.T) {
+	requireGTK(t)
+
+	view, err := SourceViewNewWithBuffer(newTestBuffer(t, "text\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	view.SetTabWidth(6)
+	compositor, err := SourcePrintCompositorNewFromView(view)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := compositor.GetTabWidth(); got != 6 {
+		t.Errorf("GetTabWidth() = %d, want the tab width 6 of the view", got)
+	}
+}
+
+func TestSourcePrintCompositorExportPDF(t *testing.T) {
+	requireGTK(t)
+
+	lines := make([]string, 300)
+	for i := range lines {
+		lines[i] = "printed line"
+	}
+	compositor, err := SourcePrintCompositorNew(newTestBuffer(t, strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(tempDir(t), "out.pdf")
+	if err := compositor.ExportPDF(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		t.Errorf("ExportPDF() wrote %d bytes that are not a PDF document", len(data))
+	}
+	if got := compositor.GetNPages(); got < 2 {
+		t.Errorf("GetNPages() = %d for 300 lines, want several pages", got)
+	}
+	if got := compositor.GetPaginationProgress(); got != 1 {
+		t.Errorf("GetPaginationProgress() = %v after exporting, want 1", got)
+	}
+}
@@ -0,0 +1,175 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "region.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_region_get_type()), marshalSourceRegion},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceRegion"] = wrapSourceRegion
+}
+
+/*
+ * GtkSourceRegion
+ */
+
+// SourceRegion is a representation of GtkSourceRegion, a set of
+// non-overlapping ranges of a text buffer which are kept up to date as the
+// text changes.
+type SourceRegion struct {
+	*glib.Object
+}
+
+// SourceRegionSpan is one contiguous range of a SourceRegion.
+type SourceRegionSpan struct {
+	Start, End *gtk.TextIter
+}
+
+// native returns a pointer to the underlying GtkSourceRegion.
+func (v *SourceRegion) native() *C.GtkSourceRegion {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceRegion(p)
+}
+
+func marshalSourceRegion(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceRegion(obj), nil
+}
+
+func wrapSourceRegion(obj *glib.Object) *SourceRegion {
+	return &SourceRegion{obj}
+}
+
+// SourceRegionNew is a wrapper around gtk_source_region_new().
+func SourceRegionNew(buffer *gtk.TextBuffer) (*SourceRegion, error) {
+	c := C.gtk_source_region_new(C.toGtkTextBuffer(unsafe.Pointer(buffer.GObject)))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceRegion(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetBuffer is a wrapper around gtk_source_region_get_buffer(). It returns
+// errNilPtr once the buffer has been finalized.
+func (v *SourceRegion) GetBuffer() (*gtk.TextBuffer, error) {
+	c := C.gtk_source_region_get_buffer(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return &gtk.TextBuffer{glib.Take(unsafe.Pointer(c))}, nil
+}
+
+// AddSubregion is a wrapper around gtk_source_region_add_subregion().
+func (v *SourceRegion) AddSubregion(start, end *gtk.TextIter) {
+	C.gtk_source_region_add_subregion(v.native(), textIter(start), textIter(end))
+}
+
+// AddRegion is a wrapper around gtk_source_region_add_region().
+func (v *SourceRegion) AddRegion(region *SourceRegion) {
+	C.gtk_source_region_add_region(v.native(), region.native())
+}
+
+// SubtractSubregion is a wrapper around gtk_source_region_subtract_subregion().
+func (v *SourceRegion) SubtractSubregion(start, end *gtk.TextIter) {
+	C.gtk_source_region_subtract_subregion(v.native(), textIter(start), textIter(end))
+}
+
+// SubtractRegion is a wrapper around gtk_source_region_subtract_region().
+func (v *SourceRegion) SubtractRegion(region *SourceRegion) {
+	C.gtk_source_region_subtract_region(v.native(), region.native())
+}
+
+// IntersectSubregion is a wrapper around gtk_source_region_intersect_subregion().
+// It returns a new region, or errNilPtr if the intersection is empty.
+func (v *SourceRegion) IntersectSubregion(start, end *gtk.TextIter) (*SourceRegion, error) {
+	c := C.gtk_source_region_intersect_subregion(v.native(), textIter(start), textIter(end))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceRegion(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// IntersectRegion is a wrapper around gtk_source_region_intersect_region().
+// It returns a new region, or errNilPtr if the intersection is empty.
+func (v *SourceRegion) IntersectRegion(region *SourceRegion) (*SourceRegion, error) {
+	c := C.gtk_source_region_intersect_region(v.native(), region.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceRegion(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// IsEmpty is a wrapper around gtk_source_region_is_empty().
+func (v *SourceRegion) IsEmpty() bool {
+	return gobool(C.gtk_source_region_is_empty(v.native()))
+}
+
+// GetBounds is a wrapper around gtk_source_region_get_bounds().
+// The last return value is false if the region is empty.
+func (v *SourceRegion) GetBounds() (start, end *gtk.TextIter, ok bool) {
+	var cstart, cend C.GtkTextIter
+	if !gobool(C.gtk_source_region_get_bounds(v.native(), &cstart, &cend)) {
+		return nil, nil, false
+	}
+	return (*gtk.TextIter)(unsafe.Pointer(&cstart)), (*gtk.TextIter)(unsafe.Pointer(&cend)), true
+}
+
+// ToString is a wrapper around gtk_source_region_to_string(). It is meant
+// for debugging.
+func (v *SourceRegion) ToString() string {
+	c := C.gtk_source_region_to_string(v.native())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// All returns an iterator over the subregions, in order. With Go 1.23 or
+// later it can be used directly in a for range loop:
+//
+//	for start, end := range region.All() {
+//		...
+//	}
+//
+// The region must not be modified during the iteration.
+func (v *SourceRegion) All() func(yield func(start, end *gtk.TextIter) bool) {
+	return func(yield func(start, end *gtk.TextIter) bool) {
+		var iter C.GtkSourceRegionIter
+		C.gtk_source_region_get_start_region_iter(v.native(), &iter)
+		for ; !gobool(C.gtk_source_region_iter_is_end(&iter)); C.gtk_source_region_iter_next(&iter) {
+			var cstart, cend C.GtkTextIter
+			if !gobool(C.gtk_source_region_iter_get_subregion(&iter, &cstart, &cend)) {
+				return
+			}
+			if !yield((*gtk.TextIter)(unsafe.Pointer(&cstart)), (*gtk.TextIter)(unsafe.Pointer(&cend))) {
+				return
+			}
+		}
+	}
+}
+
+// Subregions returns all the subregions, in order. It is the slice
+// counterpart of All.
+func (v *SourceRegion) Subregions() []SourceRegionSpan {
+	var spans []SourceRegionSpan
+	v.All()(func(start, end *gtk.TextIter) bool {
+		spans = append(spans, SourceRegionSpan{start, end})
+		return true
+	})
+	return spans
+}
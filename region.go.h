@@ -0,0 +1,13 @@
+#include <gtk/gtk.h>
+
+static GtkSourceRegion *
+toGtkSourceRegion(void *p)
+{
+	return (GTK_SOURCE_REGION(p));
+}
+
+static GtkTextBuffer *
+toGtkTextBuffer(void *p)
+{
+	return (GTK_TEXT_BUFFER(p));
+}
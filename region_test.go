@@ -0,0 +1,105 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// spanOffsets returns the character offsets of the subregions of region.
+func spanOffsets(region *SourceRegion) [][2]int {
+	var offsets [][2]int
+	for _, span := range region.Subregions() {
+		offsets = append(offsets, [2]int{span.Start.GetOffset(), span.End.GetOffset()})
+	}
+	return offsets
+}
+
+func equalOffsets(a, b [][2]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSourceRegionSetOperations(t *testing.T) {
+	requireGTK(t)
+	buffer := newTestBuffer(t, "0123456789")
+
+	region, err := SourceRegionNew(&buffer.TextBuffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !region.IsEmpty() {
+		t.Error("IsEmpty() = false for a new region")
+	}
+	if _, _, ok := region.GetBounds(); ok {
+		t.Error("GetBounds() ok = true for an empty region")
+	}
+	if got, err := region.GetBuffer(); err != nil || got.Native() != buffer.Native() {
+		t.Errorf("GetBuffer() = %v, %v, want the region buffer", got, err)
+	}
+
+	region.AddSubregion(buffer.GetIterAtOffset(0), buffer.GetIterAtOffset(3))
+	region.AddSubregion(buffer.GetIterAtOffset(5), buffer.GetIterAtOffset(8))
+	if want := [][2]int{{0, 3}, {5, 8}}; !equalOffsets(spanOffsets(region), want) {
+		t.Errorf("after AddSubregion, subregions = %v, want %v", spanOffsets(region), want)
+	}
+
+	region.SubtractSubregion(buffer.GetIterAtOffset(1), buffer.GetIterAtOffset(2))
+	if want := [][2]int{{0, 1}, {2, 3}, {5, 8}}; !equalOffsets(spanOffsets(region), want) {
+		t.Errorf("after SubtractSubregion, subregions = %v, want %v", spanOffsets(region), want)
+	}
+
+	start, end, ok := region.GetBounds()
+	if !ok || start.GetOffset() != 0 || end.GetOffset() != 8 {
+		t.Errorf("GetBounds() = %d, %d, %v, want 0, 8, true", start.GetOffset(), end.GetOffset(), ok)
+	}
+
+	intersection, err := region.IntersectSubregion(buffer.GetIterAtOffset(2), buffer.GetIterAtOffset(6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][2]int{{2, 3}, {5, 6}}; !equalOffsets(spanOffsets(intersection), want) {
+		t.Errorf("IntersectSubregion() subregions = %v, want %v", spanOffsets(intersection), want)
+	}
+
+	other, err := SourceRegionNew(&buffer.TextBuffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.AddSubregion(buffer.GetIterAtOffset(7), buffer.GetIterAtOffset(10))
+	both, err := region.IntersectRegion(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][2]int{{7, 8}}; !equalOffsets(spanOffsets(both), want) {
+		t.Errorf("IntersectRegion() subregions = %v, want %v", spanOffsets(both), want)
+	}
+
+	region.AddRegion(other)
+	if want := [][2]int{{0, 1}, {2, 3}, {5, 10}}; !equalOffsets(spanOffsets(region), want) {
+		t.Errorf("after AddRegion, subregions = %v, want %v", spanOffsets(region), want)
+	}
+	region.SubtractRegion(intersection)
+	if want := [][2]int{{0, 1}, {6, 10}}; !equalOffsets(spanOffsets(region), want) {
+		t.Errorf("after SubtractRegion, subregions = %v, want %v", spanOffsets(region), want)
+	}
+	if region.ToString() == "" {
+		t.Error("ToString() = \"\" for a non-empty region")
+	}
+
+	var n int
+	region.All()(func(start, end *gtk.TextIter) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("All() called yield %d times after it returned false, want 1", n)
+	}
+}
@@ -0,0 +1,61 @@
+package sourceview
+
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// SourceStyleSchemeSync keeps the style scheme of a set of buffers in sync
+// with the scheme selected in a style scheme chooser.
+type SourceStyleSchemeSync struct {
+	chooser *SourceStyleSchemeChooser
+	handle  glib.SignalHandle
+	buffers []*SourceBuffer
+}
+
+// SourceStyleSchemeSyncNew applies the scheme of chooser to buffers, and to
+// any buffer added later, every time it changes. Call Disconnect once the
+// synchronisation is no longer needed, so the buffers can be freed.
+func SourceStyleSchemeSyncNew(chooser ISourceStyleSchemeChooser, buffers ...*SourceBuffer) *SourceStyleSchemeSync {
+	obj := glib.Take(unsafe.Pointer(chooser.toSourceStyleSchemeChooser()))
+	s := &SourceStyleSchemeSync{chooser: wrapSourceStyleSchemeChooser(obj)}
+	s.handle = s.chooser.OnSchemeChanged(func(scheme *SourceStyleScheme) {
+		for _, buffer := range s.buffers {
+			buffer.SetStyleScheme(scheme)
+		}
+	})
+	for _, buffer := range buffers {
+		s.Add(buffer)
+	}
+	return s
+}
+
+// Add applies the current scheme to buffer and keeps it in sync.
+func (s *SourceStyleSchemeSync) Add(buffer *SourceBuffer) {
+	for _, b := range s.buffers {
+		if b.GObject == buffer.GObject {
+			return
+		}
+	}
+	s.buffers = append(s.buffers, buffer)
+	if scheme := s.chooser.GetScheme(); scheme != nil {
+		buffer.SetStyleScheme(scheme)
+	}
+}
+
+// Remove stops keeping buffer in sync. Its current scheme is left as is.
+func (s *SourceStyleSchemeSync) Remove(buffer *SourceBuffer) {
+	for i, b := range s.buffers {
+		if b.GObject == buffer.GObject {
+			s.buffers = append(s.buffers[:i], s.buffers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Disconnect stops the synchronisation of all the buffers.
+func (s *SourceStyleSchemeSync) Disconnect() {
+	s.chooser.HandlerDisconnect(s.handle)
+	s.buffers = nil
+}
@@ -0,0 +1,74 @@
+package sourceview
+
+import (
+	"testing"
+)
+
+// schemeID returns the ID of the style scheme of buffer.
+func schemeID(t *testing.T, buffer *SourceBuffer) string {
+	t.Helper()
+	scheme, err := buffer.GetStyleScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := scheme.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestSourceStyleSchemeSync(t *testing.T) {
+	requireGTK(t)
+
+	sm, err := SourceStyleSchemeManagerGetDefault()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheme := func(id string) *SourceStyleScheme {
+		s := sm.GetScheme(id)
+		if s == nil {
+			t.Fatalf("GetScheme(%q) returned nil", id)
+		}
+		return s
+	}
+
+	chooser, err := SourceStyleSchemeChooserWidgetNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chooser.SetScheme(scheme("classic"))
+
+	first := newTestBuffer(t, "")
+	second := newTestBuffer(t, "")
+	sync := SourceStyleSchemeSyncNew(chooser, first)
+	if got := schemeID(t, first); got != "classic" {
+		t.Errorf("scheme of the first buffer = %q, want %q", got, "classic")
+	}
+	sync.Add(second)
+	if got := schemeID(t, second); got != "classic" {
+		t.Errorf("scheme of the added buffer = %q, want %q", got, "classic")
+	}
+
+	chooser.SetScheme(scheme("cobalt"))
+	for i, buffer := range []*SourceBuffer{first, second} {
+		if got := schemeID(t, buffer); got != "cobalt" {
+			t.Errorf("scheme of buffer %d = %q after the chooser changed, want %q", i, got, "cobalt")
+		}
+	}
+
+	sync.Remove(second)
+	chooser.SetScheme(scheme("kate"))
+	if got := schemeID(t, first); got != "kate" {
+		t.Errorf("scheme of the first buffer = %q, want %q", got, "kate")
+	}
+	if got := schemeID(t, second); got != "cobalt" {
+		t.Errorf("scheme of the removed buffer = %q, want it to stay %q", got, "cobalt")
+	}
+
+	sync.Disconnect()
+	chooser.SetScheme(scheme("classic"))
+	if got := schemeID(t, first); got != "kate" {
+		t.Errorf("scheme of the first buffer = %q after Disconnect, want it to stay %q", got, "kate")
+	}
+}
@@ -0,0 +1,287 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "search.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_search_context_get_type()), marshalSourceSearchContext},
+		{glib.Type(C.gtk_source_search_settings_get_type()), marshalSourceSearchSettings},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceSearchContext"] = wrapSourceSearchContext
+	gtk.WrapMap["GtkSourceSearchSettings"] = wrapSourceSearchSettings
+}
+
+var errNotSearchMatch = errors.New("range is not a search match")
+
+/*
+ * GtkSourceSearchSettings
+ */
+
+// SourceSearchSettings is a representation of GtkSourceSearchSettings.
+type SourceSearchSettings struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceSearchSettings.
+func (v *SourceSearchSettings) native() *C.GtkSourceSearchSettings {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceSearchSettings(p)
+}
+
+func marshalSourceSearchSettings(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceSearchSettings(obj), nil
+}
+
+func wrapSourceSearchSettings(obj *glib.Object) *SourceSearchSettings {
+	return &SourceSearchSettings{obj}
+}
+
+// SourceSearchSettingsNew is a wrapper around gtk_source_search_settings_new().
+func SourceSearchSettingsNew() (*SourceSearchSettings, error) {
+	c := C.gtk_source_search_settings_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceSearchSettings(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SetSearchText is a wrapper around gtk_source_search_settings_set_search_text().
+func (v *SourceSearchSettings) SetSearchText(text string) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_search_settings_set_search_text(v.native(), (*C.gchar)(cstr))
+}
+
+// GetSearchText is a wrapper around gtk_source_search_settings_get_search_text().
+func (v *SourceSearchSettings) GetSearchText() string {
+	c := C.gtk_source_search_settings_get_search_text(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// SetCaseSensitive is a wrapper around gtk_source_search_settings_set_case_sensitive().
+func (v *SourceSearchSettings) SetCaseSensitive(caseSensitive bool) {
+	C.gtk_source_search_settings_set_case_sensitive(v.native(), gbool(caseSensitive))
+}
+
+// GetCaseSensitive is a wrapper around gtk_source_search_settings_get_case_sensitive().
+func (v *SourceSearchSettings) GetCaseSensitive() bool {
+	return gobool(C.gtk_source_search_settings_get_case_sensitive(v.native()))
+}
+
+// SetAtWordBoundaries is a wrapper around gtk_source_search_settings_set_at_word_boundaries().
+func (v *SourceSearchSettings) SetAtWordBoundaries(atWordBoundaries bool) {
+	C.gtk_source_search_settings_set_at_word_boundaries(v.native(), gbool(atWordBoundaries))
+}
+
+// GetAtWordBoundaries is a wrapper around gtk_source_search_settings_get_at_word_boundaries().
+func (v *SourceSearchSettings) GetAtWordBoundaries() bool {
+	return gobool(C.gtk_source_search_settings_get_at_word_boundaries(v.native()))
+}
+
+// SetWrapAround is a wrapper around gtk_source_search_settings_set_wrap_around().
+func (v *SourceSearchSettings) SetWrapAround(wrapAround bool) {
+	C.gtk_source_search_settings_set_wrap_around(v.native(), gbool(wrapAround))
+}
+
+// GetWrapAround is a wrapper around gtk_source_search_settings_get_wrap_around().
+func (v *SourceSearchSettings) GetWrapAround() bool {
+	return gobool(C.gtk_source_search_settings_get_wrap_around(v.native()))
+}
+
+// SetRegexEnabled is a wrapper around gtk_source_search_settings_set_regex_enabled().
+func (v *SourceSearchSettings) SetRegexEnabled(regexEnabled bool) {
+	C.gtk_source_search_settings_set_regex_enabled(v.native(), gbool(regexEnabled))
+}
+
+// GetRegexEnabled is a wrapper around gtk_source_search_settings_get_regex_enabled().
+func (v *SourceSearchSettings) GetRegexEnabled() bool {
+	return gobool(C.gtk_source_search_settings_get_regex_enabled(v.native()))
+}
+
+/*
+ * GtkSourceSearchContext
+ */
+
+// SourceSearchContext is a representation of GtkSourceSearchContext.
+type SourceSearchContext struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceSearchContext.
+func (v *SourceSearchContext) native() *C.GtkSourceSearchContext {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceSearchContext(p)
+}
+
+func marshalSourceSearchContext(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceSearchContext(obj), nil
+}
+
+func wrapSourceSearchContext(obj *glib.Object) *SourceSearchContext {
+	return &SourceSearchContext{obj}
+}
+
+// SourceSearchContextNew is a wrapper around gtk_source_search_context_new().
+// settings may be nil, in which case a new SourceSearchSettings is created.
+func SourceSearchContextNew(buffer *SourceBuffer, settings *SourceSearchSettings) (*SourceSearchContext, error) {
+	c := C.gtk_source_search_context_new(buffer.native(), settings.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceSearchContext(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetBuffer is a wrapper around gtk_source_search_context_get_buffer().
+func (v *SourceSearchContext) GetBuffer() (*SourceBuffer, error) {
+	c := C.gtk_source_search_context_get_buffer(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceBuffer(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetSettings is a wrapper around gtk_source_search_context_get_settings().
+func (v *SourceSearchContext) GetSettings() (*SourceSearchSettings, error) {
+	c := C.gtk_source_search_context_get_settings(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceSearchSettings(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetHighlight is a wrapper around gtk_source_search_context_set_highlight().
+func (v *SourceSearchContext) SetHighlight(highlight bool) {
+	C.gtk_source_search_context_set_highlight(v.native(), gbool(highlight))
+}
+
+// GetHighlight is a wrapper around gtk_source_search_context_get_highlight().
+func (v *SourceSearchContext) GetHighlight() bool {
+	return gobool(C.gtk_source_search_context_get_highlight(v.native()))
+}
+
+// SetMatchStyle is a wrapper around gtk_source_search_context_set_match_style().
+func (v *SourceSearchContext) SetMatchStyle(style *SourceStyle) {
+	C.gtk_source_search_context_set_match_style(v.native(), style.native())
+}
+
+// GetMatchStyle is a wrapper around gtk_source_search_context_get_match_style().
+func (v *SourceSearchContext) GetMatchStyle() *SourceStyle {
+	c := C.gtk_source_search_context_get_match_style(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapSourceStyle(glib.Take(unsafe.Pointer(c)))
+}
+
+// GetRegexError is a wrapper around gtk_source_search_context_get_regex_error().
+// It returns nil if the search pattern is not a regex or is a valid one.
+func (v *SourceSearchContext) GetRegexError() error {
+	return goError(C.gtk_source_search_context_get_regex_error(v.native()))
+}
+
+// GetOccurrencesCount is a wrapper around gtk_source_search_context_get_occurrences_count().
+// It returns -1 while the buffer is not fully scanned.
+func (v *SourceSearchContext) GetOccurrencesCount() int {
+	return int(C.gtk_source_search_context_get_occurrences_count(v.native()))
+}
+
+// GetOccurrencePosition is a wrapper around gtk_source_search_context_get_occurrence_position().
+// It returns 0 if the range is not an occurrence and -1 if it is not yet known.
+func (v *SourceSearchContext) GetOccurrencePosition(matchStart, matchEnd *gtk.TextIter) int {
+	c := C.gtk_source_search_context_get_occurrence_position(v.native(), textIter(matchStart), textIter(matchEnd))
+	return int(c)
+}
+
+// Forward is a wrapper around gtk_source_search_context_forward2(), or
+// gtk_source_search_context_forward() in GtkSourceView 4.
+func (v *SourceSearchContext) Forward(iter *gtk.TextIter) (matchStart, matchEnd *gtk.TextIter, hasWrappedAround, found bool) {
+	var start, end gtk.TextIter
+	var wrapped C.gboolean
+	c := searchForward(v.native(), textIter(iter), textIter(&start), textIter(&end), &wrapped)
+	return &start, &end, gobool(wrapped), gobool(c)
+}
+
+// Backward is a wrapper around gtk_source_search_context_backward2(), or
+// gtk_source_search_context_backward() in GtkSourceView 4.
+func (v *SourceSearchContext) Backward(iter *gtk.TextIter) (matchStart, matchEnd *gtk.TextIter, hasWrappedAround, found bool) {
+	var start, end gtk.TextIter
+	var wrapped C.gboolean
+	c := searchBackward(v.native(), textIter(iter), textIter(&start), textIter(&end), &wrapped)
+	return &start, &end, gobool(wrapped), gobool(c)
+}
+
+// SourceSearchCallback is called with the result of ForwardAsync or
+// BackwardAsync. found is false when there is no match; err is only set when
+// the search failed, for example because it was cancelled.
+type SourceSearchCallback func(matchStart, matchEnd *gtk.TextIter, hasWrappedAround, found bool, err error)
+
+// ForwardAsync is a wrapper around gtk_source_search_context_forward_async().
+// The callback is invoked from the main loop. cancellable may be nil.
+func (v *SourceSearchContext) ForwardAsync(iter *gtk.TextIter, c *glib.Cancellable, callback SourceSearchCallback) {
+	id := assignCallback(callback)
+	C.search_context_forward_async(v.native(), textIter(iter), cancellable(c), C.guintptr(id))
+}
+
+// BackwardAsync is a wrapper around gtk_source_search_context_backward_async().
+// The callback is invoked from the main loop. cancellable may be nil.
+func (v *SourceSearchContext) BackwardAsync(iter *gtk.TextIter, c *glib.Cancellable, callback SourceSearchCallback) {
+	id := assignCallback(callback)
+	C.search_context_backward_async(v.native(), textIter(iter), cancellable(c), C.guintptr(id))
+}
+
+// Replace is a wrapper around gtk_source_search_context_replace2(), or
+// gtk_source_search_context_replace() in GtkSourceView 4. On success
+// matchStart and matchEnd are revalidated to point to the replaced text.
+func (v *SourceSearchContext) Replace(matchStart, matchEnd *gtk.TextIter, replace string) error {
+	cstr := C.CString(replace)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := searchReplace(v.native(), textIter(matchStart), textIter(matchEnd), (*C.gchar)(cstr), &err)
+	if !gobool(c) {
+		if err == nil {
+			return errNotSearchMatch
+		}
+		return goError(err)
+	}
+	return nil
+}
+
+// ReplaceAll is a wrapper around gtk_source_search_context_replace_all().
+// It returns the number of replaced matches.
+func (v *SourceSearchContext) ReplaceAll(replace string) (uint, error) {
+	cstr := C.CString(replace)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.gtk_source_search_context_replace_all(v.native(), (*C.gchar)(cstr), -1, &err)
+	if err != nil {
+		return 0, goError(err)
+	}
+	return uint(c), nil
+}
@@ -0,0 +1,32 @@
+#include <gtk/gtk.h>
+
+static GtkSourceSearchSettings *
+toGtkSourceSearchSettings(void *p)
+{
+	return (GTK_SOURCE_SEARCH_SETTINGS(p));
+}
+
+static GtkSourceSearchContext *
+toGtkSourceSearchContext(void *p)
+{
+	return (GTK_SOURCE_SEARCH_CONTEXT(p));
+}
+
+extern void goSearchContextForwardReady(GObject *source, GAsyncResult *result, gpointer user_data);
+extern void goSearchContextBackwardReady(GObject *source, GAsyncResult *result, gpointer user_data);
+
+static void
+search_context_forward_async(GtkSourceSearchContext *search, const GtkTextIter *iter,
+                             GCancellable *cancellable, guintptr id)
+{
+	gtk_source_search_context_forward_async(search, iter, cancellable,
+		goSearchContextForwardReady, (gpointer)id);
+}
+
+static void
+search_context_backward_async(GtkSourceSearchContext *search, const GtkTextIter *iter,
+                              GCancellable *cancellable, guintptr id)
+{
+	gtk_source_search_context_backward_async(search, iter, cancellable,
+		goSearchContextBackwardReady, (gpointer)id);
+}
@@ -0,0 +1,33 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+//export goSearchContextForwardReady
+func goSearchContextForwardReady(source *C.GObject, result *C.GAsyncResult, data C.gpointer) {
+	fn := getAndDeleteCallback(uintptr(data)).(SourceSearchCallback)
+
+	var start, end gtk.TextIter
+	var wrapped C.gboolean
+	var err *C.GError
+	c := searchForwardFinish((*C.GtkSourceSearchContext)(unsafe.Pointer(source)), result,
+		textIter(&start), textIter(&end), &wrapped, &err)
+	fn(&start, &end, gobool(wrapped), gobool(c), goError(err))
+}
+
+//export goSearchContextBackwardReady
+func goSearchContextBackwardReady(source *C.GObject, result *C.GAsyncResult, data C.gpointer) {
+	fn := getAndDeleteCallback(uintptr(data)).(SourceSearchCallback)
+
+	var start, end gtk.TextIter
+	var wrapped C.gboolean
+	var err *C.GError
+	c := searchBackwardFinish((*C.GtkSourceSearchContext)(unsafe.Pointer(source)), result,
+		textIter(&start), textIter(&end), &wrapped, &err)
+	fn(&start, &end, gobool(wrapped), gobool(c), goError(err))
+}
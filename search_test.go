@@ -0,0 +1,148 @@
+package sourceview
+
+import (
+	"testing"
+
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// newTestSearch returns a search context looking for text in a buffer
+// holding content.
+func newTestSearch(t *testing.T, content, text string) (*SourceBuffer, *SourceSearchSettings, *SourceSearchContext) {
+	t.Helper()
+	buffer := newTestBuffer(t, content)
+	settings, err := SourceSearchSettingsNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.SetSearchText(text)
+	search, err := SourceSearchContextNew(buffer, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buffer, settings, search
+}
+
+func TestSourceSearchSettings(t *testing.T) {
+	requireGTK(t)
+
+	settings, err := SourceSearchSettingsNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.SetSearchText("needle")
+	settings.SetCaseSensitive(true)
+	settings.SetAtWordBoundaries(true)
+	settings.SetWrapAround(true)
+	settings.SetRegexEnabled(true)
+	if got := settings.GetSearchText(); got != "needle" {
+		t.Errorf("GetSearchText() = %q, want %q", got, "needle")
+	}
+	if !settings.GetCaseSensitive() || !settings.GetAtWordBoundaries() || !settings.GetWrapAround() || !settings.GetRegexEnabled() {
+		t.Errorf("boolean getters = %v, %v, %v, %v, want all true", settings.GetCaseSensitive(),
+			settings.GetAtWordBoundaries(), settings.GetWrapAround(), settings.GetRegexEnabled())
+	}
+}
+
+func TestSourceSearchContextForwardBackward(t *testing.T) {
+	requireGTK(t)
+	buffer, settings, search := newTestSearch(t, "hello world, hello", "hello")
+
+	if got, err := search.GetBuffer(); err != nil || got.Native() != buffer.Native() {
+		t.Errorf("GetBuffer() = %v, %v, want the search buffer", got, err)
+	}
+	if got, err := search.GetSettings(); err != nil || got.Native() != settings.Native() {
+		t.Errorf("GetSettings() = %v, %v, want the search settings", got, err)
+	}
+
+	start, end, wrapped, found := search.Forward(buffer.GetIterAtOffset(1))
+	if !found || wrapped || start.GetOffset() != 13 || end.GetOffset() != 18 {
+		t.Errorf("Forward() = %d, %d, %v, %v, want 13, 18, false, true", start.GetOffset(), end.GetOffset(), wrapped, found)
+	}
+
+	start, end, _, found = search.Backward(buffer.GetIterAtOffset(13))
+	if !found || start.GetOffset() != 0 || end.GetOffset() != 5 {
+		t.Errorf("Backward() = %d, %d, %v, want 0, 5, true", start.GetOffset(), end.GetOffset(), found)
+	}
+
+	settings.SetSearchText("absent")
+	if _, _, _, found := search.Forward(buffer.GetStartIter()); found {
+		t.Error("Forward() found a match for text that is not in the buffer")
+	}
+}
+
+func TestSourceSearchContextOccurrences(t *testing.T) {
+	requireGTK(t)
+	buffer, _, search := newTestSearch(t, "a b a b a", "a")
+
+	// The occurrences are counted in the background.
+	runMainLoopUntil(t, func() bool { return search.GetOccurrencesCount() >= 0 })
+	if got := search.GetOccurrencesCount(); got != 3 {
+		t.Errorf("GetOccurrencesCount() = %d, want 3", got)
+	}
+	if got := search.GetOccurrencePosition(buffer.GetIterAtOffset(4), buffer.GetIterAtOffset(5)); got != 2 {
+		t.Errorf("GetOccurrencePosition() = %d, want 2", got)
+	}
+
+	search.SetHighlight(false)
+	if search.GetHighlight() {
+		t.Error("GetHighlight() = true after SetHighlight(false)")
+	}
+}
+
+func TestSourceSearchContextReplace(t *testing.T) {
+	requireGTK(t)
+	buffer, _, search := newTestSearch(t, "one two one", "one")
+
+	start, end, _, found := search.Forward(buffer.GetStartIter())
+	if !found {
+		t.Fatal("Forward() found no match")
+	}
+	if err := search.Replace(start, end, "1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := bufferText(t, buffer); got != "1 two one" {
+		t.Errorf("text after Replace() = %q, want %q", got, "1 two one")
+	}
+
+	n, err := search.ReplaceAll("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("ReplaceAll() = %d, want 1", n)
+	}
+	if got := bufferText(t, buffer); got != "1 two 1" {
+		t.Errorf("text after ReplaceAll() = %q, want %q", got, "1 two 1")
+	}
+}
+
+func TestSourceSearchContextRegexError(t *testing.T) {
+	requireGTK(t)
+	_, settings, search := newTestSearch(t, "text", "(")
+
+	settings.SetRegexEnabled(true)
+	runMainLoopUntil(t, func() bool { return search.GetRegexError() != nil })
+	settings.SetRegexEnabled(false)
+	if err := search.GetRegexError(); err != nil {
+		t.Errorf("GetRegexError() = %v without regular expressions", err)
+	}
+}
+
+func TestSourceSearchContextForwardAsync(t *testing.T) {
+	requireGTK(t)
+	buffer, _, search := newTestSearch(t, "hello world", "world")
+
+	var done bool
+	search.ForwardAsync(buffer.GetStartIter(), nil, func(start, end *gtk.TextIter, wrapped, found bool, err error) {
+		done = true
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !found || start.GetOffset() != 6 || end.GetOffset() != 11 {
+			t.Errorf("ForwardAsync() = %d, %d, %v, want 6, 11, true", start.GetOffset(), end.GetOffset(), found)
+		}
+	})
+	runMainLoopUntil(t, func() bool { return done })
+}
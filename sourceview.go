@@ -1,18 +1,7 @@
 package sourceview
 
 // #include <stdlib.h>
-// #cgo pkg-config: gtksourceview-3.0
-// #include <gtksourceview/gtksourcebuffer.h>
-// #include <gtksourceview/gtksourcegutter.h>
-// #include <gtksourceview/gtksourcelanguage.h>
-// #include <gtksourceview/gtksourcelanguagemanager.h>
-// #include <gtksourceview/gtksourcestyle.h>
-// #include <gtksourceview/gtksourcestylescheme.h>
-// #include <gtksourceview/gtksourcestyleschemechooser.h>
-// #include <gtksourceview/gtksourcestyleschemechooserbutton.h>
-// #include <gtksourceview/gtksourcestyleschemechooserwidget.h>
-// #include <gtksourceview/gtksourcestyleschememanager.h>
-// #include <gtksourceview/gtksourceview.h>
+// #include <gtksourceview/gtksource.h>
 // #include "sourceview.go.h"
 import "C"
 import (
@@ -61,10 +50,58 @@ func gbool(b bool) C.gboolean {
 	return C.gboolean(0)
 }
 
+func gobool(b C.gboolean) bool {
+	return b != 0
+}
+
 func goString(cstr *C.gchar) string {
 	return C.GoString((*C.char)(cstr))
 }
 
+// goStrings converts a NULL-terminated array of C strings to a slice. It
+// does not free the array.
+func goStrings(cstrs **C.gchar) []string {
+	if cstrs == nil {
+		return nil
+	}
+	var strs []string
+	for ; *cstrs != nil; cstrs = C.next_gcharptr(cstrs) {
+		strs = append(strs, goString(*cstrs))
+	}
+	return strs
+}
+
+// cstringOrNil returns a C copy of s, or nil if s is empty. The caller must
+// free the result.
+func cstringOrNil(s string) *C.gchar {
+	if s == "" {
+		return nil
+	}
+	return (*C.gchar)(C.CString(s))
+}
+
+// textIter returns the C pointer of a gtk.TextIter.
+func textIter(iter *gtk.TextIter) *C.GtkTextIter {
+	return (*C.GtkTextIter)(unsafe.Pointer(iter))
+}
+
+// cancellable returns the C pointer of a glib.Cancellable, which may be nil.
+func cancellable(c *glib.Cancellable) *C.GCancellable {
+	if c == nil || c.GObject == nil {
+		return nil
+	}
+	return C.toGCancellable(unsafe.Pointer(c.GObject))
+}
+
+// goError converts a GError to a Go error and frees it.
+func goError(err *C.GError) error {
+	if err == nil {
+		return nil
+	}
+	defer C.g_error_free(err)
+	return errors.New(goString(err.message))
+}
+
 /*
  * GtkSourceGutter
  */
@@ -97,6 +134,24 @@ func wrapSourceGutter(obj *glib.Object) *SourceGutter {
  * GtkSourceView
  */
 
+// SourceSmartHomeEndType is a representation of GtkSourceSmartHomeEndType.
+type SourceSmartHomeEndType int
+
+const (
+	SOURCE_SMART_HOME_END_DISABLED SourceSmartHomeEndType = C.GTK_SOURCE_SMART_HOME_END_DISABLED
+	SOURCE_SMART_HOME_END_BEFORE   SourceSmartHomeEndType = C.GTK_SOURCE_SMART_HOME_END_BEFORE
+	SOURCE_SMART_HOME_END_AFTER    SourceSmartHomeEndType = C.GTK_SOURCE_SMART_HOME_END_AFTER
+	SOURCE_SMART_HOME_END_ALWAYS   SourceSmartHomeEndType = C.GTK_SOURCE_SMART_HOME_END_ALWAYS
+)
+
+// SourceBackgroundPatternType is a representation of GtkSourceBackgroundPatternType.
+type SourceBackgroundPatternType int
+
+const (
+	SOURCE_BACKGROUND_PATTERN_TYPE_NONE SourceBackgroundPatternType = C.GTK_SOURCE_BACKGROUND_PATTERN_TYPE_NONE
+	SOURCE_BACKGROUND_PATTERN_TYPE_GRID SourceBackgroundPatternType = C.GTK_SOURCE_BACKGROUND_PATTERN_TYPE_GRID
+)
+
 // SourceView is a representation of GtkSourceView.
 type SourceView struct {
 	gtk.TextView
@@ -107,14 +162,146 @@ func (v *SourceView) SetHighlightCurrentLine(highlight bool) {
 	C.gtk_source_view_set_highlight_current_line(v.native(), gbool(highlight))
 }
 
+// GetHighlightCurrentLine is a wrapper around gtk_source_view_get_highlight_current_line().
+func (v *SourceView) GetHighlightCurrentLine() bool {
+	return gobool(C.gtk_source_view_get_highlight_current_line(v.native()))
+}
+
 // SetShowLineNumbers is a wrapper around gtk_source_view_set_show_line_numbers().
 func (v *SourceView) SetShowLineNumbers(show bool) {
 	C.gtk_source_view_set_show_line_numbers(v.native(), gbool(show))
 }
 
-// SetShowRightMargin is a wrapper around gtk_source_view_get_show_right_margin().
-func (v *SourceView) SetShowRightMargin() {
-	C.gtk_source_view_get_show_right_margin(v.native())
+// GetShowLineNumbers is a wrapper around gtk_source_view_get_show_line_numbers().
+func (v *SourceView) GetShowLineNumbers() bool {
+	return gobool(C.gtk_source_view_get_show_line_numbers(v.native()))
+}
+
+// SetShowRightMargin is a wrapper around gtk_source_view_set_show_right_margin().
+func (v *SourceView) SetShowRightMargin(show bool) {
+	C.gtk_source_view_set_show_right_margin(v.native(), gbool(show))
+}
+
+// GetShowRightMargin is a wrapper around gtk_source_view_get_show_right_margin().
+func (v *SourceView) GetShowRightMargin() bool {
+	return gobool(C.gtk_source_view_get_show_right_margin(v.native()))
+}
+
+// SetRightMarginPosition is a wrapper around gtk_source_view_set_right_margin_position().
+func (v *SourceView) SetRightMarginPosition(pos uint) {
+	C.gtk_source_view_set_right_margin_position(v.native(), C.guint(pos))
+}
+
+// GetRightMarginPosition is a wrapper around gtk_source_view_get_right_margin_position().
+func (v *SourceView) GetRightMarginPosition() uint {
+	return uint(C.gtk_source_view_get_right_margin_position(v.native()))
+}
+
+// SetShowLineMarks is a wrapper around gtk_source_view_set_show_line_marks().
+func (v *SourceView) SetShowLineMarks(show bool) {
+	C.gtk_source_view_set_show_line_marks(v.native(), gbool(show))
+}
+
+// GetShowLineMarks is a wrapper around gtk_source_view_get_show_line_marks().
+func (v *SourceView) GetShowLineMarks() bool {
+	return gobool(C.gtk_source_view_get_show_line_marks(v.native()))
+}
+
+// SetAutoIndent is a wrapper around gtk_source_view_set_auto_indent().
+func (v *SourceView) SetAutoIndent(enable bool) {
+	C.gtk_source_view_set_auto_indent(v.native(), gbool(enable))
+}
+
+// GetAutoIndent is a wrapper around gtk_source_view_get_auto_indent().
+func (v *SourceView) GetAutoIndent() bool {
+	return gobool(C.gtk_source_view_get_auto_indent(v.native()))
+}
+
+// SetIndentOnTab is a wrapper around gtk_source_view_set_indent_on_tab().
+func (v *SourceView) SetIndentOnTab(enable bool) {
+	C.gtk_source_view_set_indent_on_tab(v.native(), gbool(enable))
+}
+
+// GetIndentOnTab is a wrapper around gtk_source_view_get_indent_on_tab().
+func (v *SourceView) GetIndentOnTab() bool {
+	return gobool(C.gtk_source_view_get_indent_on_tab(v.native()))
+}
+
+// SetIndentWidth is a wrapper around gtk_source_view_set_indent_width().
+// A width of -1 uses the tab width.
+func (v *SourceView) SetIndentWidth(width int) {
+	C.gtk_source_view_set_indent_width(v.native(), C.gint(width))
+}
+
+// GetIndentWidth is a wrapper around gtk_source_view_get_indent_width().
+func (v *SourceView) GetIndentWidth() int {
+	return int(C.gtk_source_view_get_indent_width(v.native()))
+}
+
+// SetTabWidth is a wrapper around gtk_source_view_set_tab_width().
+func (v *SourceView) SetTabWidth(width uint) {
+	C.gtk_source_view_set_tab_width(v.native(), C.guint(width))
+}
+
+// GetTabWidth is a wrapper around gtk_source_view_get_tab_width().
+func (v *SourceView) GetTabWidth() uint {
+	return uint(C.gtk_source_view_get_tab_width(v.native()))
+}
+
+// SetInsertSpacesInsteadOfTabs is a wrapper around gtk_source_view_set_insert_spaces_instead_of_tabs().
+func (v *SourceView) SetInsertSpacesInsteadOfTabs(enable bool) {
+	C.gtk_source_view_set_insert_spaces_instead_of_tabs(v.native(), gbool(enable))
+}
+
+// GetInsertSpacesInsteadOfTabs is a wrapper around gtk_source_view_get_insert_spaces_instead_of_tabs().
+func (v *SourceView) GetInsertSpacesInsteadOfTabs() bool {
+	return gobool(C.gtk_source_view_get_insert_spaces_instead_of_tabs(v.native()))
+}
+
+// SetSmartBackspace is a wrapper around gtk_source_view_set_smart_backspace().
+func (v *SourceView) SetSmartBackspace(enable bool) {
+	C.gtk_source_view_set_smart_backspace(v.native(), gbool(enable))
+}
+
+// GetSmartBackspace is a wrapper around gtk_source_view_get_smart_backspace().
+func (v *SourceView) GetSmartBackspace() bool {
+	return gobool(C.gtk_source_view_get_smart_backspace(v.native()))
+}
+
+// SetSmartHomeEnd is a wrapper around gtk_source_view_set_smart_home_end().
+func (v *SourceView) SetSmartHomeEnd(smartHomeEnd SourceSmartHomeEndType) {
+	C.gtk_source_view_set_smart_home_end(v.native(), C.GtkSourceSmartHomeEndType(smartHomeEnd))
+}
+
+// GetSmartHomeEnd is a wrapper around gtk_source_view_get_smart_home_end().
+func (v *SourceView) GetSmartHomeEnd() SourceSmartHomeEndType {
+	return SourceSmartHomeEndType(C.gtk_source_view_get_smart_home_end(v.native()))
+}
+
+// SetBackgroundPattern is a wrapper around gtk_source_view_set_background_pattern().
+func (v *SourceView) SetBackgroundPattern(pattern SourceBackgroundPatternType) {
+	C.gtk_source_view_set_background_pattern(v.native(), C.GtkSourceBackgroundPatternType(pattern))
+}
+
+// GetBackgroundPattern is a wrapper around gtk_source_view_get_background_pattern().
+func (v *SourceView) GetBackgroundPattern() SourceBackgroundPatternType {
+	return SourceBackgroundPatternType(C.gtk_source_view_get_background_pattern(v.native()))
+}
+
+// IndentLines is a wrapper around gtk_source_view_indent_lines().
+func (v *SourceView) IndentLines(start, end *gtk.TextIter) {
+	C.gtk_source_view_indent_lines(v.native(), textIter(start), textIter(end))
+}
+
+// UnindentLines is a wrapper around gtk_source_view_unindent_lines().
+func (v *SourceView) UnindentLines(start, end *gtk.TextIter) {
+	C.gtk_source_view_unindent_lines(v.native(), textIter(start), textIter(end))
+}
+
+// GetVisualColumn is a wrapper around gtk_source_view_get_visual_column().
+// Unlike the character offset, tabs count as the number of columns they span.
+func (v *SourceView) GetVisualColumn(iter *gtk.TextIter) uint {
+	return uint(C.gtk_source_view_get_visual_column(v.native(), textIter(iter)))
 }
 
 // native returns a pointer to the underlying GtkSourceView.
@@ -217,14 +404,27 @@ func wrapSourceBuffer(obj *glib.Object) *SourceBuffer {
 	return &SourceBuffer{gtk.TextBuffer{obj}}
 }
 
-// SourceBufferNew is a wrapper around gtk_text_buffer_new().
+// SourceBufferNew is a wrapper around gtk_source_buffer_new(), creating the
+// buffer with a new tag table.
 func SourceBufferNew() (*SourceBuffer, error) {
-	c := C.gtk_text_buffer_new(nil)
+	c := C.gtk_source_buffer_new(nil)
+	if c == nil {
+		return nil, errNilPtr
+	}
+
+	e := wrapSourceBuffer(glib.AssumeOwnership(unsafe.Pointer(c)))
+	return e, nil
+}
+
+// SourceBufferNewWithTable is a wrapper around gtk_source_buffer_new(),
+// creating the buffer with the given tag table.
+func SourceBufferNewWithTable(table *gtk.TextTagTable) (*SourceBuffer, error) {
+	c := C.gtk_source_buffer_new(C.toGtkTextTagTable(unsafe.Pointer(table.GObject)))
 	if c == nil {
 		return nil, errNilPtr
 	}
 
-	e := wrapSourceBuffer(glib.Take(unsafe.Pointer(c)))
+	e := wrapSourceBuffer(glib.AssumeOwnership(unsafe.Pointer(c)))
 	return e, nil
 }
 
@@ -235,7 +435,7 @@ func SourceBufferNewWithLanguage(l *SourceLanguage) (*SourceBuffer, error) {
 		return nil, errNilPtr
 	}
 
-	e := wrapSourceBuffer(glib.Take(unsafe.Pointer(c)))
+	e := wrapSourceBuffer(glib.AssumeOwnership(unsafe.Pointer(c)))
 	return e, nil
 }
 
@@ -252,6 +452,51 @@ func (v *SourceBuffer) SetLanguage(l *SourceLanguage) {
 	C.gtk_source_buffer_set_language(v.native(), l.native())
 }
 
+// GetLanguage is a wrapper around gtk_source_buffer_get_language().
+func (v *SourceBuffer) GetLanguage() (*SourceLanguage, error) {
+	c := C.gtk_source_buffer_get_language(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceLanguage(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// SetHighlightSyntax is a wrapper around gtk_source_buffer_set_highlight_syntax().
+func (v *SourceBuffer) SetHighlightSyntax(highlight bool) {
+	C.gtk_source_buffer_set_highlight_syntax(v.native(), gbool(highlight))
+}
+
+// GetHighlightSyntax is a wrapper around gtk_source_buffer_get_highlight_syntax().
+func (v *SourceBuffer) GetHighlightSyntax() bool {
+	return gobool(C.gtk_source_buffer_get_highlight_syntax(v.native()))
+}
+
+// SetHighlightMatchingBrackets is a wrapper around gtk_source_buffer_set_highlight_matching_brackets().
+func (v *SourceBuffer) SetHighlightMatchingBrackets(highlight bool) {
+	C.gtk_source_buffer_set_highlight_matching_brackets(v.native(), gbool(highlight))
+}
+
+// GetHighlightMatchingBrackets is a wrapper around gtk_source_buffer_get_highlight_matching_brackets().
+func (v *SourceBuffer) GetHighlightMatchingBrackets() bool {
+	return gobool(C.gtk_source_buffer_get_highlight_matching_brackets(v.native()))
+}
+
+// SetImplicitTrailingNewline is a wrapper around gtk_source_buffer_set_implicit_trailing_newline().
+func (v *SourceBuffer) SetImplicitTrailingNewline(implicit bool) {
+	C.gtk_source_buffer_set_implicit_trailing_newline(v.native(), gbool(implicit))
+}
+
+// GetImplicitTrailingNewline is a wrapper around gtk_source_buffer_get_implicit_trailing_newline().
+func (v *SourceBuffer) GetImplicitTrailingNewline() bool {
+	return gobool(C.gtk_source_buffer_get_implicit_trailing_newline(v.native()))
+}
+
+// EnsureHighlight is a wrapper around gtk_source_buffer_ensure_highlight().
+// It forces the syntax highlighting of the range to be computed right away.
+func (v *SourceBuffer) EnsureHighlight(start, end *gtk.TextIter) {
+	C.gtk_source_buffer_ensure_highlight(v.native(), textIter(start), textIter(end))
+}
+
 // BeginNotUndoableAction is a wrapper around gtk_source_buffer_begin_not_undoable_action().
 func (v *SourceBuffer) BeginNotUndoableAction() {
 	C.gtk_source_buffer_begin_not_undoable_action(v.native())
@@ -263,8 +508,9 @@ func (v *SourceBuffer) EndNotUndoableAction() {
 }
 
 // GetMaxUndoLevels is a wrapper around gtk_source_buffer_get_max_undo_levels().
-func (v *SourceBuffer) GetMaxUndoLevels() {
-	C.gtk_source_buffer_get_max_undo_levels(v.native())
+// A value of -1 means the number of undo levels is unlimited.
+func (v *SourceBuffer) GetMaxUndoLevels() int {
+	return int(C.gtk_source_buffer_get_max_undo_levels(v.native()))
 }
 
 // SetMaxUndoLevels is a wrapper around gtk_source_buffer_set_max_undo_levels().
@@ -277,6 +523,15 @@ func (v *SourceBuffer) SetStyleScheme(scheme *SourceStyleScheme) {
 	C.gtk_source_buffer_set_style_scheme(v.native(), scheme.native())
 }
 
+// GetStyleScheme is a wrapper around gtk_source_buffer_get_style_scheme().
+func (v *SourceBuffer) GetStyleScheme() (*SourceStyleScheme, error) {
+	c := C.gtk_source_buffer_get_style_scheme(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceStyleScheme(glib.Take(unsafe.Pointer(c))), nil
+}
+
 /*
  * GtkSourceLanguageManager
  */
@@ -305,14 +560,14 @@ func wrapSourceLanguageManager(obj *glib.Object) *SourceLanguageManager {
 	return &SourceLanguageManager{obj}
 }
 
-// SourceLanguageManagerNew is a wrapper around gtk_text_buffer_new().
+// SourceLanguageManagerNew is a wrapper around gtk_source_language_manager_new().
 func SourceLanguageManagerNew() (*SourceLanguageManager, error) {
 	c := C.gtk_source_language_manager_new()
 	if c == nil {
 		return nil, errNilPtr
 	}
 
-	e := wrapSourceLanguageManager(glib.Take(unsafe.Pointer(c)))
+	e := wrapSourceLanguageManager(glib.AssumeOwnership(unsafe.Pointer(c)))
 	return e, nil
 }
 
@@ -338,6 +593,54 @@ func (v *SourceLanguageManager) GetLanguage(id string) (*SourceLanguage, error)
 	return wrapSourceLanguage(glib.Take(unsafe.Pointer(c))), nil
 }
 
+// GetLanguageIDs is a wrapper around gtk_source_language_manager_get_language_ids().
+func (v *SourceLanguageManager) GetLanguageIDs() []string {
+	c := C.gtk_source_language_manager_get_language_ids(v.native())
+	return goStrings((**C.gchar)(unsafe.Pointer(c)))
+}
+
+// SetSearchPath is a wrapper around gtk_source_language_manager_set_search_path().
+// Passing nil resets the search path to the default.
+func (v *SourceLanguageManager) SetSearchPath(paths []string) {
+	if paths == nil {
+		C.gtk_source_language_manager_set_search_path(v.native(), nil)
+		return
+	}
+
+	cpaths := C.make_strings(C.int(len(paths) + 1))
+	for i, path := range paths {
+		cstr := C.CString(path)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(cpaths, C.int(i), (*C.gchar)(cstr))
+	}
+
+	C.set_string(cpaths, C.int(len(paths)), nil)
+	C.gtk_source_language_manager_set_search_path(v.native(), cpaths)
+	C.destroy_strings(cpaths)
+}
+
+// GetSearchPath is a wrapper around gtk_source_language_manager_get_search_path().
+func (v *SourceLanguageManager) GetSearchPath() []string {
+	c := C.gtk_source_language_manager_get_search_path(v.native())
+	return goStrings((**C.gchar)(unsafe.Pointer(c)))
+}
+
+// GuessLanguage is a wrapper around gtk_source_language_manager_guess_language().
+// Either filename or contentType may be empty, but not both. It returns
+// errNilPtr if no language matches.
+func (v *SourceLanguageManager) GuessLanguage(filename, contentType string) (*SourceLanguage, error) {
+	cfilename := cstringOrNil(filename)
+	defer C.free(unsafe.Pointer(cfilename))
+	ctype := cstringOrNil(contentType)
+	defer C.free(unsafe.Pointer(ctype))
+
+	c := C.gtk_source_language_manager_guess_language(v.native(), cfilename, ctype)
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceLanguage(glib.Take(unsafe.Pointer(c))), nil
+}
+
 /*
  * GtkSourceLanguage
  */
@@ -366,6 +669,75 @@ func wrapSourceLanguage(obj *glib.Object) *SourceLanguage {
 	return &SourceLanguage{obj}
 }
 
+// GetID is a wrapper around gtk_source_language_get_id().
+func (v *SourceLanguage) GetID() string {
+	return goString(C.gtk_source_language_get_id(v.native()))
+}
+
+// GetName is a wrapper around gtk_source_language_get_name().
+func (v *SourceLanguage) GetName() string {
+	return goString(C.gtk_source_language_get_name(v.native()))
+}
+
+// GetSection is a wrapper around gtk_source_language_get_section().
+func (v *SourceLanguage) GetSection() string {
+	return goString(C.gtk_source_language_get_section(v.native()))
+}
+
+// GetHidden is a wrapper around gtk_source_language_get_hidden().
+func (v *SourceLanguage) GetHidden() bool {
+	return gobool(C.gtk_source_language_get_hidden(v.native()))
+}
+
+// GetMetadata is a wrapper around gtk_source_language_get_metadata().
+// The second return value is false if the metadata is not set.
+func (v *SourceLanguage) GetMetadata(name string) (string, bool) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gtk_source_language_get_metadata(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return "", false
+	}
+	return goString(c), true
+}
+
+// GetMimeTypes is a wrapper around gtk_source_language_get_mime_types().
+func (v *SourceLanguage) GetMimeTypes() []string {
+	c := C.gtk_source_language_get_mime_types(v.native())
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// GetGlobs is a wrapper around gtk_source_language_get_globs().
+func (v *SourceLanguage) GetGlobs() []string {
+	c := C.gtk_source_language_get_globs(v.native())
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// GetStyleIDs is a wrapper around gtk_source_language_get_style_ids().
+func (v *SourceLanguage) GetStyleIDs() []string {
+	c := C.gtk_source_language_get_style_ids(v.native())
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// GetStyleName is a wrapper around gtk_source_language_get_style_name().
+// It returns an empty string if the style is not defined by the language.
+func (v *SourceLanguage) GetStyleName(styleID string) string {
+	cstr := C.CString(styleID)
+	defer C.free(unsafe.Pointer(cstr))
+	return goString(C.gtk_source_language_get_style_name(v.native(), (*C.gchar)(cstr)))
+}
+
+// GetStyleFallback is a wrapper around gtk_source_language_get_style_fallback().
+// It returns an empty string if the style has no fallback.
+func (v *SourceLanguage) GetStyleFallback(styleID string) string {
+	cstr := C.CString(styleID)
+	defer C.free(unsafe.Pointer(cstr))
+	return goString(C.gtk_source_language_get_style_fallback(v.native(), (*C.gchar)(cstr)))
+}
+
 /*
  * GtkSourceStyle
  */
@@ -400,7 +772,7 @@ func (v *SourceStyle) Copy() (*SourceStyle, error) {
 	if c == nil {
 		return nil, errNilPtr
 	}
-	return wrapSourceStyle(glib.Take(unsafe.Pointer(c))), nil
+	return wrapSourceStyle(glib.AssumeOwnership(unsafe.Pointer(c))), nil
 }
 
 // Apply is a wrapper around gtk_source_style_apply().
@@ -443,9 +815,8 @@ func (v *SourceStyleScheme) GetID() (string, error) {
 	if c == nil {
 		return "", errNilPtr
 	}
-	gostr := goString(c)
-	C.g_free(C.gpointer(c))
-	return gostr, nil
+	// The string belongs to the scheme and must not be freed.
+	return goString(c), nil
 }
 
 // GetName is a wrapper around gtk_source_style_scheme_get_name().
@@ -454,9 +825,7 @@ func (v *SourceStyleScheme) GetName() (string, error) {
 	if c == nil {
 		return "", errNilPtr
 	}
-	gostr := goString(c)
-	C.g_free(C.gpointer(c))
-	return gostr, nil
+	return goString(c), nil
 }
 
 // GetDescription is a wrapper around gtk_source_style_scheme_get_description().
@@ -465,9 +834,7 @@ func (v *SourceStyleScheme) GetDescription() (string, error) {
 	if c == nil {
 		return "", errNilPtr
 	}
-	gostr := goString(c)
-	C.g_free(C.gpointer(c))
-	return gostr, nil
+	return goString(c), nil
 }
 
 // GetAuthors is a wrapper around gtk_source_style_scheme_get_authors().
@@ -493,9 +860,7 @@ func (v *SourceStyleScheme) GetFileName() (string, error) {
 	if c == nil {
 		return "", errNilPtr
 	}
-	gostr := goString(c)
-	C.g_free(C.gpointer(c))
-	return gostr, nil
+	return goString(c), nil
 }
 
 // GetStyle is a wrapper around gtk_source_style_scheme_get_style().
@@ -544,7 +909,7 @@ func SourceStyleSchemeManagerNew() (*SourceStyleSchemeManager, error) {
 	if c == nil {
 		return nil, errNilPtr
 	}
-	return wrapSourceStyleSchemeManager(glib.Take(unsafe.Pointer(c))), nil
+	return wrapSourceStyleSchemeManager(glib.AssumeOwnership(unsafe.Pointer(c))), nil
 }
 
 // SourceStyleSchemeManagerGetDefault is a wrapper around gtk_source_style_scheme_manager_get_default().
@@ -692,6 +1057,14 @@ func (v *SourceStyleSchemeChooser) SetScheme(scheme *SourceStyleScheme) {
 	C.gtk_source_style_scheme_chooser_set_style_scheme(v.native(), scheme.native())
 }
 
+// OnSchemeChanged connects f to "notify::style-scheme". f is called with the
+// newly chosen scheme.
+func (v *SourceStyleSchemeChooser) OnSchemeChanged(f func(scheme *SourceStyleScheme)) glib.SignalHandle {
+	return v.Connect("notify::style-scheme", func() {
+		f(v.GetScheme())
+	})
+}
+
 /*
  * GtkSourceStyleSchemeChooserButton
  */
@@ -743,6 +1116,15 @@ func wrapSourceStyleSchemeChooserButton(obj *glib.Object) *SourceStyleSchemeChoo
 	}
 }
 
+// SourceStyleSchemeChooserButtonNew is a wrapper around gtk_source_style_scheme_chooser_button_new().
+func SourceStyleSchemeChooserButtonNew() (*SourceStyleSchemeChooserButton, error) {
+	c := C.gtk_source_style_scheme_chooser_button_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceStyleSchemeChooserButton(glib.Take(unsafe.Pointer(c))), nil
+}
+
 /*
  * GtkSourceStyleSchemeChooserWidget
  */
@@ -30,6 +30,12 @@ toGtkTextBuffer(void *p)
 	return (GTK_TEXT_BUFFER(p));
 }
 
+static GtkTextTagTable *
+toGtkTextTagTable(void *p)
+{
+	return (GTK_TEXT_TAG_TABLE(p));
+}
+
 static GtkSourceView *
 toGtkSourceView(void *p)
 {
@@ -101,3 +107,9 @@ toGtkTextTag(void *p)
 {
 	return (GTK_TEXT_TAG(p));
 }
+
+static GCancellable *
+toGCancellable(void *p)
+{
+	return (G_CANCELLABLE(p));
+}
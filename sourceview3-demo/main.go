@@ -36,10 +36,15 @@ func testBuilder() {
 	sv.ShowAll()
 
 	lm, _ := sourceview.SourceLanguageManagerGetDefault()
-	l, _ := lm.GetLanguage("markdown")
+	l, _ := lm.GuessLanguage("README.md", "")
 	buf, _ := sv.GetBuffer()
 	buf.SetLanguage(l)
 	buf.SetText(txt)
+
+	sd, _ := sv.GetSpaceDrawer()
+	sd.SetTypesForLocations(sourceview.SOURCE_SPACE_LOCATION_LEADING|sourceview.SOURCE_SPACE_LOCATION_TRAILING,
+		sourceview.SOURCE_SPACE_TYPE_TAB|sourceview.SOURCE_SPACE_TYPE_NEWLINE|sourceview.SOURCE_SPACE_TYPE_NBSP)
+	sd.SetEnableMatrix(true)
 }
 
 func extractWindow(builder *gtk.Builder, id string) *gtk.Window {
@@ -138,7 +143,6 @@ const glade = `<?xml version="1.0" encoding="UTF-8"?>
             <property name="show_right_margin">True</property>
             <property name="smart_home_end">always</property>
             <property name="highlight_current_line">True</property>
-            <property name="draw_spaces">GTK_SOURCE_DRAW_SPACES_TAB | GTK_SOURCE_DRAW_SPACES_NEWLINE | GTK_SOURCE_DRAW_SPACES_NBSP | GTK_SOURCE_DRAW_SPACES_LEADING | GTK_SOURCE_DRAW_SPACES_TRAILING</property>
             <property name="smart_backspace">True</property>
           </object>
         </child>
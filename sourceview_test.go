@@ -0,0 +1,263 @@
+package sourceview
+
+import (
+	"reflect"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// accessorCalls is how often the accessor tests call each getter, enough
+// for one leaked or missing reference per call to stand out, and for a
+// string freed by mistake to corrupt the heap.
+const accessorCalls = 5000
+
+// gobject mirrors the start of the C GObject struct to read its reference
+// count, which GLib does not expose.
+type gobject struct {
+	gTypeInstance uintptr
+	refCount      uint32
+}
+
+func refCount(obj *glib.Object) uint32 {
+	return atomic.LoadUint32(&(*gobject)(unsafe.Pointer(obj.GObject)).refCount)
+}
+
+// settleRefCount runs the garbage collector until the finalizers of dropped
+// wrappers have released their references and the reference count of obj
+// stops changing, and returns it.
+func settleRefCount(obj *glib.Object) uint32 {
+	n := refCount(obj)
+	for i := 0; i < 100; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+		m := refCount(obj)
+		if m == n && i > 2 {
+			break
+		}
+		n = m
+	}
+	return n
+}
+
+// checkRefCount calls f accessorCalls times and fails if that changed the
+// reference count of obj once the garbage collector has run.
+func checkRefCount(t *testing.T, obj *glib.Object, f func()) {
+	t.Helper()
+	before := settleRefCount(obj)
+	for i := 0; i < accessorCalls; i++ {
+		f()
+	}
+	if after := settleRefCount(obj); after != before {
+		t.Errorf("reference count went from %d to %d after %d calls", before, after, accessorCalls)
+	}
+}
+
+// accessorFixture holds a view set up so that every accessor has something
+// to return.
+type accessorFixture struct {
+	view     *SourceView
+	buffer   *SourceBuffer
+	lm       *SourceLanguageManager
+	language *SourceLanguage
+	sm       *SourceStyleSchemeManager
+	scheme   *SourceStyleScheme
+	style    *SourceStyle
+	attrs    *SourceMarkAttributes
+	chooser  *SourceStyleSchemeChooserWidget
+}
+
+func newAccessorFixture(t *testing.T) *accessorFixture {
+	t.Helper()
+	var f accessorFixture
+	var err error
+
+	if f.lm, err = SourceLanguageManagerGetDefault(); err != nil {
+		t.Fatal(err)
+	}
+	if f.language, err = f.lm.GetLanguage("c"); err != nil {
+		t.Fatal(err)
+	}
+	if f.sm, err = SourceStyleSchemeManagerGetDefault(); err != nil {
+		t.Fatal(err)
+	}
+	if f.scheme = f.sm.GetScheme("classic"); f.scheme == nil {
+		t.Fatal("GetScheme(\"classic\") returned nil")
+	}
+	if f.style, err = f.scheme.GetStyle("def:comment"); err != nil {
+		t.Fatal(err)
+	}
+
+	if f.buffer, err = SourceBufferNew(); err != nil {
+		t.Fatal(err)
+	}
+	f.buffer.SetLanguage(f.language)
+	f.buffer.SetStyleScheme(f.scheme)
+	f.buffer.SetText("int main(void)\n{\n\treturn 0;\n}\n")
+	if f.view, err = SourceViewNewWithBuffer(f.buffer); err != nil {
+		t.Fatal(err)
+	}
+	if f.attrs, err = SourceMarkAttributesNew(); err != nil {
+		t.Fatal(err)
+	}
+	f.view.SetMarkAttributes("bookmark", f.attrs, 1)
+
+	if f.chooser, err = SourceStyleSchemeChooserWidgetNew(); err != nil {
+		t.Fatal(err)
+	}
+	f.chooser.SetScheme(f.scheme)
+	return &f
+}
+
+func TestObjectAccessorRefCounts(t *testing.T) {
+	requireGTK(t)
+	f := newAccessorFixture(t)
+
+	completion, err := f.view.GetCompletion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := completion.GetInfoWindow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gutter, err := f.view.GetGutter(gtk.TEXT_WINDOW_LEFT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drawer, err := f.view.GetSpaceDrawer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager, err := f.buffer.GetUndoManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultManager, ok := manager.(*sourceUndoManager)
+	if !ok {
+		t.Fatalf("GetUndoManager() = %T, want the default *sourceUndoManager", manager)
+	}
+
+	tests := []struct {
+		name string
+		obj  *glib.Object
+		call func()
+	}{
+		{"SourceView.GetBuffer", f.buffer.Object, func() { f.view.GetBuffer() }},
+		{"SourceView.GetCompletion", completion.Object, func() { f.view.GetCompletion() }},
+		{"SourceView.GetGutter", gutter.Object, func() { f.view.GetGutter(gtk.TEXT_WINDOW_LEFT) }},
+		{"SourceView.GetMarkAttributes", f.attrs.Object, func() { f.view.GetMarkAttributes("bookmark") }},
+		{"SourceView.GetSpaceDrawer", drawer.Object, func() { f.view.GetSpaceDrawer() }},
+		{"SourceBuffer.GetLanguage", f.language.Object, func() { f.buffer.GetLanguage() }},
+		{"SourceBuffer.GetStyleScheme", f.scheme.Object, func() { f.buffer.GetStyleScheme() }},
+		{"SourceBuffer.GetUndoManager", defaultManager.Object, func() { f.buffer.GetUndoManager() }},
+		{"SourceLanguageManagerGetDefault", f.lm.Object, func() { SourceLanguageManagerGetDefault() }},
+		{"SourceLanguageManager.GetLanguage", f.language.Object, func() { f.lm.GetLanguage("c") }},
+		{"SourceLanguageManager.GuessLanguage", f.language.Object, func() { f.lm.GuessLanguage("main.c", "") }},
+		{"SourceStyleSchemeManagerGetDefault", f.sm.Object, func() { SourceStyleSchemeManagerGetDefault() }},
+		{"SourceStyleSchemeManager.GetScheme", f.scheme.Object, func() { f.sm.GetScheme("classic") }},
+		{"SourceStyleScheme.GetStyle", f.style.Object, func() { f.scheme.GetStyle("def:comment") }},
+		{"SourceStyle.Copy", f.style.Object, func() { f.style.Copy() }},
+		{"SourceStyleSchemeChooser.GetScheme", f.scheme.Object, func() { f.chooser.GetScheme() }},
+		{"SourceCompletion.GetView", f.view.Object, func() { completion.GetView() }},
+		{"SourceCompletion.GetInfoWindow", info.Object, func() { completion.GetInfoWindow() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkRefCount(t, tt.obj, tt.call)
+		})
+	}
+}
+
+// stringResult holds the results of the getters that can fail.
+type stringResult struct {
+	s   string
+	err error
+}
+
+func result(s string, err error) stringResult {
+	return stringResult{s, err}
+}
+
+func TestStringAccessorsRepeated(t *testing.T) {
+	requireGTK(t)
+	f := newAccessorFixture(t)
+
+	tests := []struct {
+		name string
+		get  func() interface{}
+	}{
+		{"SourceLanguage.GetID", func() interface{} { return f.language.GetID() }},
+		{"SourceLanguage.GetName", func() interface{} { return f.language.GetName() }},
+		{"SourceLanguage.GetSection", func() interface{} { return f.language.GetSection() }},
+		{"SourceLanguage.GetMetadata", func() interface{} { s, _ := f.language.GetMetadata("line-comment-start"); return s }},
+		{"SourceLanguage.GetMimeTypes", func() interface{} { return f.language.GetMimeTypes() }},
+		{"SourceLanguage.GetGlobs", func() interface{} { return f.language.GetGlobs() }},
+		{"SourceLanguage.GetStyleIDs", func() interface{} { return f.language.GetStyleIDs() }},
+		{"SourceLanguage.GetStyleName", func() interface{} { return f.language.GetStyleName("c:comment") }},
+		{"SourceLanguage.GetStyleFallback", func() interface{} { return f.language.GetStyleFallback("c:comment") }},
+		{"SourceLanguageManager.GetLanguageIDs", func() interface{} { return f.lm.GetLanguageIDs() }},
+		{"SourceLanguageManager.GetSearchPath", func() interface{} { return f.lm.GetSearchPath() }},
+		{"SourceStyleScheme.GetID", func() interface{} { return result(f.scheme.GetID()) }},
+		{"SourceStyleScheme.GetName", func() interface{} { return result(f.scheme.GetName()) }},
+		{"SourceStyleScheme.GetDescription", func() interface{} { return result(f.scheme.GetDescription()) }},
+		{"SourceStyleScheme.GetAuthors", func() interface{} { return f.scheme.GetAuthors() }},
+		{"SourceStyleScheme.GetFileName", func() interface{} { return result(f.scheme.GetFileName()) }},
+		{"SourceStyleSchemeManager.GetSearchPath", func() interface{} { return f.sm.GetSearchPath() }},
+		{"SourceStyleSchemeManager.GetSchemeIDs", func() interface{} { return f.sm.GetSchemeIDs() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.get()
+			if r, ok := want.(stringResult); ok && r.err != nil {
+				t.Fatal(r.err)
+			}
+			for i := 0; i < accessorCalls; i++ {
+				if got := tt.get(); !reflect.DeepEqual(got, want) {
+					t.Fatalf("call %d returned %v, want %v", i, got, want)
+				}
+			}
+			runtime.GC()
+		})
+	}
+}
+
+func TestSourceBufferNew(t *testing.T) {
+	requireGTK(t)
+
+	buffer, err := SourceBufferNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buffer.TypeFromInstance(), glib.TypeFromName("GtkSourceBuffer"); got != want {
+		t.Errorf("SourceBufferNew() created a %s, want %s", got.Name(), want.Name())
+	}
+}
+
+func TestSourceBufferNewWithTable(t *testing.T) {
+	requireGTK(t)
+
+	table, err := gtk.TextTagTableNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer, err := SourceBufferNewWithTable(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buffer.TypeFromInstance(), glib.TypeFromName("GtkSourceBuffer"); got != want {
+		t.Errorf("SourceBufferNewWithTable() created a %s, want %s", got.Name(), want.Name())
+	}
+	got, err := buffer.GetTagTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Native() != table.Native() {
+		t.Error("GetTagTable() did not return the table passed to SourceBufferNewWithTable()")
+	}
+}
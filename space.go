@@ -0,0 +1,218 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "space.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_space_drawer_get_type()), marshalSourceSpaceDrawer},
+		{glib.Type(C.gtk_source_tag_get_type()), marshalSourceTag},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceSpaceDrawer"] = wrapSourceSpaceDrawer
+	gtk.WrapMap["GtkSourceTag"] = wrapSourceTag
+}
+
+// SourceSpaceTypeFlags is a representation of GtkSourceSpaceTypeFlags.
+type SourceSpaceTypeFlags int
+
+const (
+	SOURCE_SPACE_TYPE_NONE    SourceSpaceTypeFlags = C.GTK_SOURCE_SPACE_TYPE_NONE
+	SOURCE_SPACE_TYPE_SPACE   SourceSpaceTypeFlags = C.GTK_SOURCE_SPACE_TYPE_SPACE
+	SOURCE_SPACE_TYPE_TAB     SourceSpaceTypeFlags = C.GTK_SOURCE_SPACE_TYPE_TAB
+	SOURCE_SPACE_TYPE_NEWLINE SourceSpaceTypeFlags = C.GTK_SOURCE_SPACE_TYPE_NEWLINE
+	SOURCE_SPACE_TYPE_NBSP    SourceSpaceTypeFlags = C.GTK_SOURCE_SPACE_TYPE_NBSP
+	SOURCE_SPACE_TYPE_ALL     SourceSpaceTypeFlags = C.GTK_SOURCE_SPACE_TYPE_ALL
+)
+
+// SourceSpaceLocationFlags is a representation of GtkSourceSpaceLocationFlags.
+type SourceSpaceLocationFlags int
+
+const (
+	SOURCE_SPACE_LOCATION_NONE        SourceSpaceLocationFlags = C.GTK_SOURCE_SPACE_LOCATION_NONE
+	SOURCE_SPACE_LOCATION_LEADING     SourceSpaceLocationFlags = C.GTK_SOURCE_SPACE_LOCATION_LEADING
+	SOURCE_SPACE_LOCATION_INSIDE_TEXT SourceSpaceLocationFlags = C.GTK_SOURCE_SPACE_LOCATION_INSIDE_TEXT
+	SOURCE_SPACE_LOCATION_TRAILING    SourceSpaceLocationFlags = C.GTK_SOURCE_SPACE_LOCATION_TRAILING
+	SOURCE_SPACE_LOCATION_ALL         SourceSpaceLocationFlags = C.GTK_SOURCE_SPACE_LOCATION_ALL
+)
+
+/*
+ * GtkSourceSpaceDrawer
+ */
+
+// SourceSpaceDrawer is a representation of GtkSourceSpaceDrawer.
+type SourceSpaceDrawer struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying GtkSourceSpaceDrawer.
+func (v *SourceSpaceDrawer) native() *C.GtkSourceSpaceDrawer {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceSpaceDrawer(p)
+}
+
+func marshalSourceSpaceDrawer(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceSpaceDrawer(obj), nil
+}
+
+func wrapSourceSpaceDrawer(obj *glib.Object) *SourceSpaceDrawer {
+	return &SourceSpaceDrawer{obj}
+}
+
+// SourceSpaceDrawerNew is a wrapper around gtk_source_space_drawer_new().
+func SourceSpaceDrawerNew() (*SourceSpaceDrawer, error) {
+	c := C.gtk_source_space_drawer_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceSpaceDrawer(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// GetSpaceDrawer is a wrapper around gtk_source_view_get_space_drawer().
+func (v *SourceView) GetSpaceDrawer() (*SourceSpaceDrawer, error) {
+	c := C.gtk_source_view_get_space_drawer(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceSpaceDrawer(glib.Take(unsafe.Pointer(c))), nil
+}
+
+// GetTypesForLocations is a wrapper around gtk_source_space_drawer_get_types_for_locations().
+// If several locations are given, the result is the intersection of their types.
+func (v *SourceSpaceDrawer) GetTypesForLocations(locations SourceSpaceLocationFlags) SourceSpaceTypeFlags {
+	c := C.gtk_source_space_drawer_get_types_for_locations(v.native(),
+		C.GtkSourceSpaceLocationFlags(locations))
+	return SourceSpaceTypeFlags(c)
+}
+
+// SetTypesForLocations is a wrapper around gtk_source_space_drawer_set_types_for_locations().
+func (v *SourceSpaceDrawer) SetTypesForLocations(locations SourceSpaceLocationFlags, types SourceSpaceTypeFlags) {
+	C.gtk_source_space_drawer_set_types_for_locations(v.native(),
+		C.GtkSourceSpaceLocationFlags(locations), C.GtkSourceSpaceTypeFlags(types))
+}
+
+// GetMatrix is a wrapper around gtk_source_space_drawer_get_matrix().
+// The result is a variant of type "au" holding the space types drawn for
+// each location.
+func (v *SourceSpaceDrawer) GetMatrix() *glib.Variant {
+	c := C.gtk_source_space_drawer_get_matrix(v.native())
+	if c == nil {
+		return nil
+	}
+	// TakeVariant adds its own reference to the non-floating result.
+	defer C.g_variant_unref(c)
+	return glib.TakeVariant(unsafe.Pointer(c))
+}
+
+// SetMatrix is a wrapper around gtk_source_space_drawer_set_matrix().
+// A nil matrix draws no spaces.
+func (v *SourceSpaceDrawer) SetMatrix(matrix *glib.Variant) {
+	C.gtk_source_space_drawer_set_matrix(v.native(),
+		C.toGVariant(unsafe.Pointer(matrix.Native())))
+}
+
+// GetEnableMatrix is a wrapper around gtk_source_space_drawer_get_enable_matrix().
+func (v *SourceSpaceDrawer) GetEnableMatrix() bool {
+	return gobool(C.gtk_source_space_drawer_get_enable_matrix(v.native()))
+}
+
+// SetEnableMatrix is a wrapper around gtk_source_space_drawer_set_enable_matrix().
+// When disabled, spaces are only drawn inside SourceTags with draw-spaces set.
+func (v *SourceSpaceDrawer) SetEnableMatrix(enable bool) {
+	C.gtk_source_space_drawer_set_enable_matrix(v.native(), gbool(enable))
+}
+
+// BindMatrixSetting is a wrapper around gtk_source_space_drawer_bind_matrix_setting().
+// The key must be of type "au".
+func (v *SourceSpaceDrawer) BindMatrixSetting(settings *glib.Settings, key string, flags glib.SettingsBindFlags) {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gtk_source_space_drawer_bind_matrix_setting(v.native(),
+		C.toGSettings(unsafe.Pointer(settings.Native())), (*C.gchar)(cstr),
+		C.GSettingsBindFlags(flags))
+}
+
+/*
+ * GtkSourceTag
+ */
+
+// SourceTag is a representation of GtkSourceTag, a GtkTextTag with extra
+// properties understood by SourceView.
+type SourceTag struct {
+	gtk.TextTag
+}
+
+// native returns a pointer to the underlying GtkSourceTag.
+func (v *SourceTag) native() *C.GtkSourceTag {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceTag(p)
+}
+
+func marshalSourceTag(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceTag(obj), nil
+}
+
+func wrapSourceTag(obj *glib.Object) *SourceTag {
+	return &SourceTag{gtk.TextTag{obj}}
+}
+
+// SourceTagNew is a wrapper around gtk_source_tag_new(). An empty name
+// creates an anonymous tag.
+func SourceTagNew(name string) (*SourceTag, error) {
+	cstr := cstringOrNil(name)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gtk_source_tag_new(cstr)
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceTag(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// SetDrawSpaces sets the "draw-spaces" property, which forces spaces inside
+// the tag to be drawn or not drawn regardless of the SourceSpaceDrawer matrix.
+func (v *SourceTag) SetDrawSpaces(draw bool) error {
+	return v.SetProperty("draw-spaces", draw)
+}
+
+// GetDrawSpaces returns the "draw-spaces" property.
+func (v *SourceTag) GetDrawSpaces() (bool, error) {
+	return v.boolProperty("draw-spaces")
+}
+
+// SetDrawSpacesSet sets the "draw-spaces-set" property. Unsetting it makes
+// the tag stop affecting how spaces are drawn.
+func (v *SourceTag) SetDrawSpacesSet(set bool) error {
+	return v.SetProperty("draw-spaces-set", set)
+}
+
+// GetDrawSpacesSet returns the "draw-spaces-set" property.
+func (v *SourceTag) GetDrawSpacesSet() (bool, error) {
+	return v.boolProperty("draw-spaces-set")
+}
+
+func (v *SourceTag) boolProperty(name string) (bool, error) {
+	p, err := v.GetProperty(name)
+	if err != nil {
+		return false, err
+	}
+	return p.(bool), nil
+}
@@ -0,0 +1,25 @@
+#include <gtk/gtk.h>
+
+static GtkSourceSpaceDrawer *
+toGtkSourceSpaceDrawer(void *p)
+{
+	return (GTK_SOURCE_SPACE_DRAWER(p));
+}
+
+static GtkSourceTag *
+toGtkSourceTag(void *p)
+{
+	return (GTK_SOURCE_TAG(p));
+}
+
+static GSettings *
+toGSettings(void *p)
+{
+	return (G_SETTINGS(p));
+}
+
+static GVariant *
+toGVariant(void *p)
+{
+	return ((GVariant *)(p));
+}
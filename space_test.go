@@ -0,0 +1,81 @@
+package sourceview
+
+import (
+	"testing"
+)
+
+func TestSourceSpaceDrawer(t *testing.T) {
+	requireGTK(t)
+
+	drawer, err := SourceSpaceDrawerNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	drawer.SetTypesForLocations(SOURCE_SPACE_LOCATION_LEADING, SOURCE_SPACE_TYPE_SPACE|SOURCE_SPACE_TYPE_TAB)
+	drawer.SetTypesForLocations(SOURCE_SPACE_LOCATION_TRAILING, SOURCE_SPACE_TYPE_TAB)
+	if got := drawer.GetTypesForLocations(SOURCE_SPACE_LOCATION_LEADING); got != SOURCE_SPACE_TYPE_SPACE|SOURCE_SPACE_TYPE_TAB {
+		t.Errorf("GetTypesForLocations(LEADING) = %v, want SPACE|TAB", got)
+	}
+	if got := drawer.GetTypesForLocations(SOURCE_SPACE_LOCATION_LEADING | SOURCE_SPACE_LOCATION_TRAILING); got != SOURCE_SPACE_TYPE_TAB {
+		t.Errorf("GetTypesForLocations(LEADING|TRAILING) = %v, want TAB", got)
+	}
+	if got := drawer.GetTypesForLocations(SOURCE_SPACE_LOCATION_INSIDE_TEXT); got != SOURCE_SPACE_TYPE_NONE {
+		t.Errorf("GetTypesForLocations(INSIDE_TEXT) = %v, want NONE", got)
+	}
+
+	matrix := drawer.GetMatrix()
+	if matrix == nil {
+		t.Fatal("GetMatrix() = nil")
+	}
+	if got := matrix.TypeString(); got != "au" {
+		t.Errorf("GetMatrix() type = %q, want %q", got, "au")
+	}
+	other, err := SourceSpaceDrawerNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.SetMatrix(matrix)
+	if got := other.GetTypesForLocations(SOURCE_SPACE_LOCATION_TRAILING); got != SOURCE_SPACE_TYPE_TAB {
+		t.Errorf("GetTypesForLocations(TRAILING) = %v after SetMatrix, want TAB", got)
+	}
+	other.SetMatrix(nil)
+	if got := other.GetTypesForLocations(SOURCE_SPACE_LOCATION_LEADING); got != SOURCE_SPACE_TYPE_NONE {
+		t.Errorf("GetTypesForLocations(LEADING) = %v after SetMatrix(nil), want NONE", got)
+	}
+
+	drawer.SetEnableMatrix(true)
+	if !drawer.GetEnableMatrix() {
+		t.Error("GetEnableMatrix() = false after SetEnableMatrix(true)")
+	}
+	drawer.SetEnableMatrix(false)
+	if drawer.GetEnableMatrix() {
+		t.Error("GetEnableMatrix() = true after SetEnableMatrix(false)")
+	}
+}
+
+func TestSourceTagDrawSpaces(t *testing.T) {
+	requireGTK(t)
+
+	tag, err := SourceTagNew("spaces")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set, err := tag.GetDrawSpacesSet(); err != nil || set {
+		t.Errorf("GetDrawSpacesSet() = %v, %v for a new tag, want false", set, err)
+	}
+	if err := tag.SetDrawSpaces(true); err != nil {
+		t.Fatal(err)
+	}
+	if draw, err := tag.GetDrawSpaces(); err != nil || !draw {
+		t.Errorf("GetDrawSpaces() = %v, %v, want true", draw, err)
+	}
+	if set, err := tag.GetDrawSpacesSet(); err != nil || !set {
+		t.Errorf("GetDrawSpacesSet() = %v, %v after SetDrawSpaces, want true", set, err)
+	}
+	if err := tag.SetDrawSpacesSet(false); err != nil {
+		t.Fatal(err)
+	}
+	if set, err := tag.GetDrawSpacesSet(); err != nil || set {
+		t.Errorf("GetDrawSpacesSet() = %v, %v after SetDrawSpacesSet(false), want false", set, err)
+	}
+}
@@ -0,0 +1,122 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "style.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/pango"
+)
+
+// The properties of a GtkSourceStyle can only be set when it is constructed,
+// which GtkSourceView does when loading a style scheme, so only getters are
+// provided. Each attribute is only meaningful when its *Set flag is true.
+
+func (v *SourceStyle) stringProperty(name string) string {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	c := C.source_style_get_string(v.native(), (*C.gchar)(cname))
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+func (v *SourceStyle) boolProperty(name string) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return gobool(C.source_style_get_boolean(v.native(), (*C.gchar)(cname)))
+}
+
+// GetForeground returns the "foreground" property, a colour such as "#rrggbb".
+func (v *SourceStyle) GetForeground() string {
+	return v.stringProperty("foreground")
+}
+
+// GetForegroundSet returns the "foreground-set" property.
+func (v *SourceStyle) GetForegroundSet() bool {
+	return v.boolProperty("foreground-set")
+}
+
+// GetBackground returns the "background" property, a colour such as "#rrggbb".
+func (v *SourceStyle) GetBackground() string {
+	return v.stringProperty("background")
+}
+
+// GetBackgroundSet returns the "background-set" property.
+func (v *SourceStyle) GetBackgroundSet() bool {
+	return v.boolProperty("background-set")
+}
+
+// GetLineBackground returns the "line-background" property, the colour of
+// the whole line, such as "#rrggbb".
+func (v *SourceStyle) GetLineBackground() string {
+	return v.stringProperty("line-background")
+}
+
+// GetLineBackgroundSet returns the "line-background-set" property.
+func (v *SourceStyle) GetLineBackgroundSet() bool {
+	return v.boolProperty("line-background-set")
+}
+
+// GetBold returns the "bold" property.
+func (v *SourceStyle) GetBold() bool {
+	return v.boolProperty("bold")
+}
+
+// GetBoldSet returns the "bold-set" property.
+func (v *SourceStyle) GetBoldSet() bool {
+	return v.boolProperty("bold-set")
+}
+
+// GetItalic returns the "italic" property.
+func (v *SourceStyle) GetItalic() bool {
+	return v.boolProperty("italic")
+}
+
+// GetItalicSet returns the "italic-set" property.
+func (v *SourceStyle) GetItalicSet() bool {
+	return v.boolProperty("italic-set")
+}
+
+// GetUnderline returns the "pango-underline" property.
+func (v *SourceStyle) GetUnderline() pango.Underline {
+	return pango.Underline(C.source_style_get_underline(v.native()))
+}
+
+// GetUnderlineSet returns the "underline-set" property.
+func (v *SourceStyle) GetUnderlineSet() bool {
+	return v.boolProperty("underline-set")
+}
+
+// GetUnderlineColor returns the "underline-color" property, a colour such
+// as "#rrggbb".
+func (v *SourceStyle) GetUnderlineColor() string {
+	return v.stringProperty("underline-color")
+}
+
+// GetUnderlineColorSet returns the "underline-color-set" property.
+func (v *SourceStyle) GetUnderlineColorSet() bool {
+	return v.boolProperty("underline-color-set")
+}
+
+// GetStrikethrough returns the "strikethrough" property.
+func (v *SourceStyle) GetStrikethrough() bool {
+	return v.boolProperty("strikethrough")
+}
+
+// GetStrikethroughSet returns the "strikethrough-set" property.
+func (v *SourceStyle) GetStrikethroughSet() bool {
+	return v.boolProperty("strikethrough-set")
+}
+
+// GetScale returns the "scale" property, either a number such as "1.2" or
+// a named scale such as "large".
+func (v *SourceStyle) GetScale() string {
+	return v.stringProperty("scale")
+}
+
+// GetScaleSet returns the "scale-set" property.
+func (v *SourceStyle) GetScaleSet() bool {
+	return v.boolProperty("scale-set")
+}
@@ -0,0 +1,25 @@
+#include <gtk/gtk.h>
+
+static gchar *
+source_style_get_string(GtkSourceStyle *style, const gchar *name)
+{
+	gchar *value = NULL;
+	g_object_get(style, name, &value, NULL);
+	return value;
+}
+
+static gboolean
+source_style_get_boolean(GtkSourceStyle *style, const gchar *name)
+{
+	gboolean value = FALSE;
+	g_object_get(style, name, &value, NULL);
+	return value;
+}
+
+static PangoUnderline
+source_style_get_underline(GtkSourceStyle *style)
+{
+	PangoUnderline value = PANGO_UNDERLINE_NONE;
+	g_object_get(style, "pango-underline", &value, NULL);
+	return value;
+}
@@ -0,0 +1,113 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "transform.go.h"
+import "C"
+import (
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// SourceChangeCaseType is a representation of GtkSourceChangeCaseType.
+type SourceChangeCaseType int
+
+const (
+	SOURCE_CHANGE_CASE_LOWER  SourceChangeCaseType = C.GTK_SOURCE_CHANGE_CASE_LOWER
+	SOURCE_CHANGE_CASE_UPPER  SourceChangeCaseType = C.GTK_SOURCE_CHANGE_CASE_UPPER
+	SOURCE_CHANGE_CASE_TOGGLE SourceChangeCaseType = C.GTK_SOURCE_CHANGE_CASE_TOGGLE
+	SOURCE_CHANGE_CASE_TITLE  SourceChangeCaseType = C.GTK_SOURCE_CHANGE_CASE_TITLE
+)
+
+// SourceSortFlags is a representation of GtkSourceSortFlags.
+type SourceSortFlags int
+
+const (
+	SOURCE_SORT_FLAGS_NONE              SourceSortFlags = C.GTK_SOURCE_SORT_FLAGS_NONE
+	SOURCE_SORT_FLAGS_CASE_SENSITIVE    SourceSortFlags = C.GTK_SOURCE_SORT_FLAGS_CASE_SENSITIVE
+	SOURCE_SORT_FLAGS_REVERSE_ORDER     SourceSortFlags = C.GTK_SOURCE_SORT_FLAGS_REVERSE_ORDER
+	SOURCE_SORT_FLAGS_REMOVE_DUPLICATES SourceSortFlags = C.GTK_SOURCE_SORT_FLAGS_REMOVE_DUPLICATES
+)
+
+// ChangeCase is a wrapper around gtk_source_buffer_change_case().
+func (v *SourceBuffer) ChangeCase(caseType SourceChangeCaseType, start, end *gtk.TextIter) {
+	C.gtk_source_buffer_change_case(v.native(), C.GtkSourceChangeCaseType(caseType), textIter(start), textIter(end))
+}
+
+// JoinLines is a wrapper around gtk_source_buffer_join_lines().
+func (v *SourceBuffer) JoinLines(start, end *gtk.TextIter) {
+	C.gtk_source_buffer_join_lines(v.native(), textIter(start), textIter(end))
+}
+
+// SortLines is a wrapper around gtk_source_buffer_sort_lines().
+// Lines are compared from the given character column onwards.
+func (v *SourceBuffer) SortLines(start, end *gtk.TextIter, flags SourceSortFlags, column int) {
+	C.gtk_source_buffer_sort_lines(v.native(), textIter(start), textIter(end),
+		C.GtkSourceSortFlags(flags), C.gint(column))
+}
+
+// OnChangeCase connects f to the "change-case" keybinding signal, which
+// changes the case of the selection or of the character at the cursor.
+func (v *SourceView) OnChangeCase(f func(caseType SourceChangeCaseType)) glib.SignalHandle {
+	return v.Connect("change-case", func(_ interface{}, caseType int) {
+		f(SourceChangeCaseType(caseType))
+	})
+}
+
+// EmitChangeCase emits the "change-case" signal, as the keybinding does.
+func (v *SourceView) EmitChangeCase(caseType SourceChangeCaseType) {
+	C.source_view_emit_change_case(v.native(), C.GtkSourceChangeCaseType(caseType))
+}
+
+// OnChangeNumber connects f to the "change-number" keybinding signal, which
+// adds count to the number at the cursor.
+func (v *SourceView) OnChangeNumber(f func(count int)) glib.SignalHandle {
+	return v.Connect("change-number", func(_ interface{}, count int) {
+		f(count)
+	})
+}
+
+// EmitChangeNumber emits the "change-number" signal, as the keybinding does.
+func (v *SourceView) EmitChangeNumber(count int) {
+	C.source_view_emit_change_number(v.native(), C.gint(count))
+}
+
+// OnJoinLines connects f to the "join-lines" keybinding signal, which joins
+// the selected lines.
+func (v *SourceView) OnJoinLines(f func()) glib.SignalHandle {
+	return v.Connect("join-lines", func(_ interface{}) {
+		f()
+	})
+}
+
+// EmitJoinLines emits the "join-lines" signal, as the keybinding does.
+func (v *SourceView) EmitJoinLines() {
+	C.source_view_emit_join_lines(v.native())
+}
+
+// OnMoveLines connects f to the "move-lines" keybinding signal, which moves
+// the selected lines up (count < 0) or down (count > 0). The copy argument
+// GtkSourceView 3 still has is not passed on. GtkSourceView 4 only knows up
+// or down, so f always gets 1 or -1 there.
+func (v *SourceView) OnMoveLines(f func(count int)) glib.SignalHandle {
+	return connectMoveLines(v, f)
+}
+
+// EmitMoveLines emits the "move-lines" signal, as the keybinding does.
+// GtkSourceView 4 ignores the size of count and moves the lines one line
+// down if it is positive, and one line up otherwise.
+func (v *SourceView) EmitMoveLines(count int) {
+	emitMoveLines(v.native(), count)
+}
+
+// OnMoveWords connects f to the "move-words" keybinding signal, which moves
+// the word at the cursor count words to the left or right.
+func (v *SourceView) OnMoveWords(f func(count int)) glib.SignalHandle {
+	return v.Connect("move-words", func(_ interface{}, count int) {
+		f(count)
+	})
+}
+
+// EmitMoveWords emits the "move-words" signal, as the keybinding does.
+func (v *SourceView) EmitMoveWords(count int) {
+	C.source_view_emit_move_words(v.native(), C.gint(count))
+}
@@ -0,0 +1,25 @@
+#include <gtk/gtk.h>
+
+static void
+source_view_emit_change_case(GtkSourceView *view, GtkSourceChangeCaseType case_type)
+{
+	g_signal_emit_by_name(view, "change-case", case_type);
+}
+
+static void
+source_view_emit_change_number(GtkSourceView *view, gint count)
+{
+	g_signal_emit_by_name(view, "change-number", count);
+}
+
+static void
+source_view_emit_join_lines(GtkSourceView *view)
+{
+	g_signal_emit_by_name(view, "join-lines");
+}
+
+static void
+source_view_emit_move_words(GtkSourceView *view, gint count)
+{
+	g_signal_emit_by_name(view, "move-words", count);
+}
@@ -0,0 +1,162 @@
+package sourceview
+
+import (
+	"testing"
+)
+
+func TestSourceBufferChangeCase(t *testing.T) {
+	requireGTK(t)
+
+	tests := []struct {
+		caseType   SourceChangeCaseType
+		start, end int
+		want       string
+	}{
+		{SOURCE_CHANGE_CASE_UPPER, 0, 5, "HELLO World"},
+		{SOURCE_CHANGE_CASE_LOWER, 0, 11, "hello world"},
+		{SOURCE_CHANGE_CASE_TOGGLE, 0, 11, "HELLO wORLD"},
+		{SOURCE_CHANGE_CASE_TITLE, 0, 11, "Hello World"},
+	}
+	for _, tt := range tests {
+		buffer := newTestBuffer(t, "hello World")
+		buffer.ChangeCase(tt.caseType, buffer.GetIterAtOffset(tt.start), buffer.GetIterAtOffset(tt.end))
+		if got := bufferText(t, buffer); got != tt.want {
+			t.Errorf("ChangeCase(%v, %d, %d) = %q, want %q", tt.caseType, tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestSourceBufferJoinLines(t *testing.T) {
+	requireGTK(t)
+	buffer := newTestBuffer(t, "one\n   two\nthree\nfour")
+
+	// Every line the range touches is joined.
+	buffer.JoinLines(buffer.GetIterAtOffset(1), buffer.GetIterAtOffset(12))
+	if got, want := bufferText(t, buffer), "one two three\nfour"; got != want {
+		t.Errorf("JoinLines() = %q, want %q", got, want)
+	}
+}
+
+func TestSourceBufferSortLines(t *testing.T) {
+	requireGTK(t)
+
+	tests := []struct {
+		name   string
+		flags  SourceSortFlags
+		column int
+		want   string
+	}{
+		{"none", SOURCE_SORT_FLAGS_NONE, 0, "a 3\nb 1\nb 1\nc 2"},
+		{"reverse", SOURCE_SORT_FLAGS_REVERSE_ORDER, 0, "c 2\nb 1\nb 1\na 3"},
+		{"remove duplicates", SOURCE_SORT_FLAGS_REMOVE_DUPLICATES, 0, "a 3\nb 1\nc 2"},
+		{"column", SOURCE_SORT_FLAGS_NONE, 2, "b 1\nb 1\nc 2\na 3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := newTestBuffer(t, "b 1\na 3\nc 2\nb 1")
+			start, end := buffer.GetBounds()
+			buffer.SortLines(start, end, tt.flags, tt.column)
+			if got := bufferText(t, buffer); got != tt.want {
+				t.Errorf("SortLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTransformView returns a view on a buffer holding text, with the cursor
+// at offset.
+func newTransformView(t *testing.T, text string, offset int) (*SourceView, *SourceBuffer) {
+	t.Helper()
+	buffer := newTestBuffer(t, text)
+	buffer.PlaceCursor(buffer.GetIterAtOffset(offset))
+	view, err := SourceViewNewWithBuffer(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return view, buffer
+}
+
+func TestSourceViewEmitChangeCase(t *testing.T) {
+	requireGTK(t)
+	view, buffer := newTransformView(t, "abc def", 0)
+	buffer.SelectRange(buffer.GetIterAtOffset(0), buffer.GetIterAtOffset(3))
+
+	var got SourceChangeCaseType = -1
+	view.OnChangeCase(func(caseType SourceChangeCaseType) { got = caseType })
+	view.EmitChangeCase(SOURCE_CHANGE_CASE_UPPER)
+	if got != SOURCE_CHANGE_CASE_UPPER {
+		t.Errorf("OnChangeCase() handler got %v, want SOURCE_CHANGE_CASE_UPPER", got)
+	}
+	if text := bufferText(t, buffer); text != "ABC def" {
+		t.Errorf("text after EmitChangeCase() = %q, want %q", text, "ABC def")
+	}
+}
+
+func TestSourceViewEmitChangeNumber(t *testing.T) {
+	requireGTK(t)
+	view, buffer := newTransformView(t, "value 41;", 7)
+
+	var got int
+	view.OnChangeNumber(func(count int) { got = count })
+	view.EmitChangeNumber(1)
+	if got != 1 {
+		t.Errorf("OnChangeNumber() handler got %d, want 1", got)
+	}
+	if text := bufferText(t, buffer); text != "value 42;" {
+		t.Errorf("text after EmitChangeNumber() = %q, want %q", text, "value 42;")
+	}
+}
+
+func TestSourceViewEmitJoinLines(t *testing.T) {
+	requireGTK(t)
+	view, buffer := newTransformView(t, "one\ntwo\nthree", 0)
+	buffer.SelectRange(buffer.GetIterAtOffset(0), buffer.GetIterAtOffset(5))
+
+	var called bool
+	view.OnJoinLines(func() { called = true })
+	view.EmitJoinLines()
+	if !called {
+		t.Error("OnJoinLines() handler was not called")
+	}
+	if text := bufferText(t, buffer); text != "one two\nthree" {
+		t.Errorf("text after EmitJoinLines() = %q, want %q", text, "one two\nthree")
+	}
+}
+
+func TestSourceViewEmitMoveLines(t *testing.T) {
+	requireGTK(t)
+	view, buffer := newTransformView(t, "one\ntwo\nthree\n", 0)
+
+	var got int
+	view.OnMoveLines(func(count int) { got = count })
+	view.EmitMoveLines(1)
+	if got != 1 {
+		t.Errorf("OnMoveLines() handler got %d, want 1", got)
+	}
+	if text := bufferText(t, buffer); text != "two\none\nthree\n" {
+		t.Errorf("text after EmitMoveLines(1) = %q, want %q", text, "two\none\nthree\n")
+	}
+
+	view.EmitMoveLines(-1)
+	if got != -1 {
+		t.Errorf("OnMoveLines() handler got %d, want -1", got)
+	}
+	if text := bufferText(t, buffer); text != "one\ntwo\nthree\n" {
+		t.Errorf("text after EmitMoveLines(-1) = %q, want %q", text, "one\ntwo\nthree\n")
+	}
+}
+
+func TestSourceViewEmitMoveWords(t *testing.T) {
+	requireGTK(t)
+	view, buffer := newTransformView(t, "one two", 1)
+
+	var got int
+	view.OnMoveWords(func(count int) { got = count })
+	view.EmitMoveWords(1)
+	if got != 1 {
+		t.Errorf("OnMoveWords() handler got %d, want 1", got)
+	}
+	if text := bufferText(t, buffer); text != "two one" {
+		t.Errorf("text after EmitMoveWords() = %q, want %q", text, "two one")
+	}
+}
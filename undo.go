@@ -0,0 +1,232 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+// #include "undo.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+)
+
+// CanUndo is a wrapper around gtk_source_buffer_can_undo().
+func (v *SourceBuffer) CanUndo() bool {
+	return gobool(C.gtk_source_buffer_can_undo(v.native()))
+}
+
+// CanRedo is a wrapper around gtk_source_buffer_can_redo().
+func (v *SourceBuffer) CanRedo() bool {
+	return gobool(C.gtk_source_buffer_can_redo(v.native()))
+}
+
+// Undo is a wrapper around gtk_source_buffer_undo().
+func (v *SourceBuffer) Undo() {
+	C.gtk_source_buffer_undo(v.native())
+}
+
+// Redo is a wrapper around gtk_source_buffer_redo().
+func (v *SourceBuffer) Redo() {
+	C.gtk_source_buffer_redo(v.native())
+}
+
+// OnUndo connects f to the "undo" signal, which is emitted to undo the last
+// user action.
+func (v *SourceBuffer) OnUndo(f func()) glib.SignalHandle {
+	return v.Connect("undo", func(_ interface{}) {
+		f()
+	})
+}
+
+// OnRedo connects f to the "redo" signal, which is emitted to redo the last
+// undone user action.
+func (v *SourceBuffer) OnRedo(f func()) glib.SignalHandle {
+	return v.Connect("redo", func(_ interface{}) {
+		f()
+	})
+}
+
+// OnCanUndoChanged connects f to "notify::can-undo". f is called with the
+// new value whenever it may have changed.
+func (v *SourceBuffer) OnCanUndoChanged(f func(canUndo bool)) glib.SignalHandle {
+	return v.Connect("notify::can-undo", func(buffer *SourceBuffer) {
+		f(buffer.CanUndo())
+	})
+}
+
+// OnCanRedoChanged connects f to "notify::can-redo". f is called with the
+// new value whenever it may have changed.
+func (v *SourceBuffer) OnCanRedoChanged(f func(canRedo bool)) glib.SignalHandle {
+	return v.Connect("notify::can-redo", func(buffer *SourceBuffer) {
+		f(buffer.CanRedo())
+	})
+}
+
+// WithNotUndoable runs f between BeginNotUndoableAction and
+// EndNotUndoableAction, so the changes made by f cannot be undone and the
+// undo history is cleared. The action is ended even if f panics.
+func (v *SourceBuffer) WithNotUndoable(f func()) {
+	v.BeginNotUndoableAction()
+	defer v.EndNotUndoableAction()
+	f()
+}
+
+// WithUserAction runs f between gtk_text_buffer_begin_user_action() and
+// gtk_text_buffer_end_user_action(), so the changes made by f are undone
+// and redone as a single step. The action is ended even if f panics.
+func (v *SourceBuffer) WithUserAction(f func()) {
+	C.gtk_text_buffer_begin_user_action(v.asTextBuffer())
+	defer C.gtk_text_buffer_end_user_action(v.asTextBuffer())
+	f()
+}
+
+/*
+ * GtkSourceUndoManager
+ */
+
+// SourceUndoManager is the Go representation of the GtkSourceUndoManager
+// interface. Implementations written in Go can be installed on a buffer with
+// SourceBuffer.SetUndoManager, and must call SourceUndoManagerCanUndoChanged
+// and SourceUndoManagerCanRedoChanged whenever the results of CanUndo and
+// CanRedo change.
+type SourceUndoManager interface {
+	CanUndo() bool
+	CanRedo() bool
+	Undo()
+	Redo()
+	BeginNotUndoableAction()
+	EndNotUndoableAction()
+}
+
+// SetUndoManager is a wrapper around gtk_source_buffer_set_undo_manager().
+// Passing nil restores the default undo manager.
+func (v *SourceBuffer) SetUndoManager(manager SourceUndoManager) {
+	if manager == nil {
+		C.gtk_source_buffer_set_undo_manager(v.native(), nil)
+		return
+	}
+
+	m := undoManagerNative(manager)
+	defer C.g_object_unref(C.gpointer(m))
+	C.gtk_source_buffer_set_undo_manager(v.native(), m)
+}
+
+// GetUndoManager is a wrapper around gtk_source_buffer_get_undo_manager().
+func (v *SourceBuffer) GetUndoManager() (SourceUndoManager, error) {
+	c := C.gtk_source_buffer_get_undo_manager(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapUndoManager(unsafe.Pointer(c)), nil
+}
+
+// SourceUndoManagerCanUndoChanged is a wrapper around
+// gtk_source_undo_manager_can_undo_changed(). It does nothing if manager is
+// not installed on any buffer.
+func SourceUndoManagerCanUndoChanged(manager SourceUndoManager) {
+	if m := lookupUndoManagerNative(manager); m != nil {
+		C.gtk_source_undo_manager_can_undo_changed(m)
+	}
+}
+
+// SourceUndoManagerCanRedoChanged is a wrapper around
+// gtk_source_undo_manager_can_redo_changed(). It does nothing if manager is
+// not installed on any buffer.
+func SourceUndoManagerCanRedoChanged(manager SourceUndoManager) {
+	if m := lookupUndoManagerNative(manager); m != nil {
+		C.gtk_source_undo_manager_can_redo_changed(m)
+	}
+}
+
+// goUndoManager is the registry entry for a SourceUndoManager implemented in
+// Go and the GoUndoManager instance that wraps it.
+type goUndoManager struct {
+	manager SourceUndoManager
+	native  *C.GtkSourceUndoManager
+}
+
+// lookupUndoManagerNative returns the GtkSourceUndoManager for manager
+// without creating one, or nil.
+func lookupUndoManagerNative(manager SourceUndoManager) *C.GtkSourceUndoManager {
+	if m, ok := manager.(interface {
+		toSourceUndoManager() *C.GtkSourceUndoManager
+	}); ok {
+		return m.toSourceUndoManager()
+	}
+
+	id := findCallback(func(v interface{}) bool {
+		e, ok := v.(*goUndoManager)
+		return ok && e.manager == manager
+	})
+	if id == 0 {
+		return nil
+	}
+	return getCallback(id).(*goUndoManager).native
+}
+
+// undoManagerNative returns a new reference to the GtkSourceUndoManager for
+// manager, wrapping it in a GoUndoManager if needed.
+func undoManagerNative(manager SourceUndoManager) *C.GtkSourceUndoManager {
+	if m := lookupUndoManagerNative(manager); m != nil {
+		C.g_object_ref(C.gpointer(m))
+		return m
+	}
+
+	e := &goUndoManager{manager: manager}
+	e.native = C.go_undo_manager_new(C.guintptr(assignCallback(e)))
+	return e.native
+}
+
+// wrapUndoManager returns the SourceUndoManager behind a
+// GtkSourceUndoManager pointer.
+func wrapUndoManager(p unsafe.Pointer) SourceUndoManager {
+	if gobool(C.isGoUndoManager(p)) {
+		id := uintptr(C.goUndoManagerID(p))
+		if e, ok := getCallback(id).(*goUndoManager); ok {
+			return e.manager
+		}
+	}
+	return &sourceUndoManager{glib.Take(p)}
+}
+
+// sourceUndoManager wraps a GtkSourceUndoManager implemented in C, such as
+// the default one of a buffer.
+type sourceUndoManager struct {
+	*glib.Object
+}
+
+func (v *sourceUndoManager) toSourceUndoManager() *C.GtkSourceUndoManager {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	return C.toGtkSourceUndoManager(unsafe.Pointer(v.GObject))
+}
+
+// CanUndo is a wrapper around gtk_source_undo_manager_can_undo().
+func (v *sourceUndoManager) CanUndo() bool {
+	return gobool(C.gtk_source_undo_manager_can_undo(v.toSourceUndoManager()))
+}
+
+// CanRedo is a wrapper around gtk_source_undo_manager_can_redo().
+func (v *sourceUndoManager) CanRedo() bool {
+	return gobool(C.gtk_source_undo_manager_can_redo(v.toSourceUndoManager()))
+}
+
+// Undo is a wrapper around gtk_source_undo_manager_undo().
+func (v *sourceUndoManager) Undo() {
+	C.gtk_source_undo_manager_undo(v.toSourceUndoManager())
+}
+
+// Redo is a wrapper around gtk_source_undo_manager_redo().
+func (v *sourceUndoManager) Redo() {
+	C.gtk_source_undo_manager_redo(v.toSourceUndoManager())
+}
+
+// BeginNotUndoableAction is a wrapper around gtk_source_undo_manager_begin_not_undoable_action().
+func (v *sourceUndoManager) BeginNotUndoableAction() {
+	C.gtk_source_undo_manager_begin_not_undoable_action(v.toSourceUndoManager())
+}
+
+// EndNotUndoableAction is a wrapper around gtk_source_undo_manager_end_not_undoable_action().
+func (v *sourceUndoManager) EndNotUndoableAction() {
+	C.gtk_source_undo_manager_end_not_undoable_action(v.toSourceUndoManager())
+}
@@ -0,0 +1,120 @@
+#include <gtk/gtk.h>
+
+static GtkSourceUndoManager *
+toGtkSourceUndoManager(void *p)
+{
+	return (GTK_SOURCE_UNDO_MANAGER(p));
+}
+
+/*
+ * GoUndoManager is a GObject implementing GtkSourceUndoManager whose
+ * virtual functions dispatch to a Go SourceUndoManager.
+ */
+
+extern gboolean goUndoManagerCanUndo(guintptr id);
+extern gboolean goUndoManagerCanRedo(guintptr id);
+extern void goUndoManagerUndo(guintptr id);
+extern void goUndoManagerRedo(guintptr id);
+extern void goUndoManagerBeginNotUndoableAction(guintptr id);
+extern void goUndoManagerEndNotUndoableAction(guintptr id);
+extern void goUndoManagerFinalize(guintptr id);
+
+typedef struct {
+	GObject parent_instance;
+	guintptr id;
+} GoUndoManager;
+
+typedef struct {
+	GObjectClass parent_class;
+} GoUndoManagerClass;
+
+static void go_undo_manager_iface_init(GtkSourceUndoManagerIface *iface);
+
+G_DEFINE_TYPE_WITH_CODE(GoUndoManager, go_undo_manager, G_TYPE_OBJECT,
+	G_IMPLEMENT_INTERFACE(GTK_SOURCE_TYPE_UNDO_MANAGER,
+		go_undo_manager_iface_init))
+
+static gboolean
+go_undo_manager_can_undo(GtkSourceUndoManager *manager)
+{
+	return goUndoManagerCanUndo(((GoUndoManager *)manager)->id);
+}
+
+static gboolean
+go_undo_manager_can_redo(GtkSourceUndoManager *manager)
+{
+	return goUndoManagerCanRedo(((GoUndoManager *)manager)->id);
+}
+
+static void
+go_undo_manager_undo(GtkSourceUndoManager *manager)
+{
+	goUndoManagerUndo(((GoUndoManager *)manager)->id);
+}
+
+static void
+go_undo_manager_redo(GtkSourceUndoManager *manager)
+{
+	goUndoManagerRedo(((GoUndoManager *)manager)->id);
+}
+
+static void
+go_undo_manager_begin_not_undoable_action(GtkSourceUndoManager *manager)
+{
+	goUndoManagerBeginNotUndoableAction(((GoUndoManager *)manager)->id);
+}
+
+static void
+go_undo_manager_end_not_undoable_action(GtkSourceUndoManager *manager)
+{
+	goUndoManagerEndNotUndoableAction(((GoUndoManager *)manager)->id);
+}
+
+static void
+go_undo_manager_iface_init(GtkSourceUndoManagerIface *iface)
+{
+	iface->can_undo = go_undo_manager_can_undo;
+	iface->can_redo = go_undo_manager_can_redo;
+	iface->undo = go_undo_manager_undo;
+	iface->redo = go_undo_manager_redo;
+	iface->begin_not_undoable_action = go_undo_manager_begin_not_undoable_action;
+	iface->end_not_undoable_action = go_undo_manager_end_not_undoable_action;
+}
+
+static void
+go_undo_manager_finalize(GObject *object)
+{
+	goUndoManagerFinalize(((GoUndoManager *)object)->id);
+	G_OBJECT_CLASS(go_undo_manager_parent_class)->finalize(object);
+}
+
+static void
+go_undo_manager_class_init(GoUndoManagerClass *klass)
+{
+	G_OBJECT_CLASS(klass)->finalize = go_undo_manager_finalize;
+}
+
+static void
+go_undo_manager_init(GoUndoManager *self)
+{
+}
+
+static GtkSourceUndoManager *
+go_undo_manager_new(guintptr id)
+{
+	GoUndoManager *self = g_object_new(go_undo_manager_get_type(), NULL);
+	self->id = id;
+	return GTK_SOURCE_UNDO_MANAGER(self);
+}
+
+static gboolean
+isGoUndoManager(void *p)
+{
+	return G_TYPE_CHECK_INSTANCE_TYPE(p, go_undo_manager_get_type());
+}
+
+static guintptr
+goUndoManagerID(void *p)
+{
+	return ((GoUndoManager *)p)->id;
+}
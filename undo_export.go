@@ -0,0 +1,43 @@
+package sourceview
+
+// #include <gtksourceview/gtksource.h>
+import "C"
+
+func undoManager(id C.guintptr) SourceUndoManager {
+	return getCallback(uintptr(id)).(*goUndoManager).manager
+}
+
+//export goUndoManagerCanUndo
+func goUndoManagerCanUndo(id C.guintptr) C.gboolean {
+	return gbool(undoManager(id).CanUndo())
+}
+
+//export goUndoManagerCanRedo
+func goUndoManagerCanRedo(id C.guintptr) C.gboolean {
+	return gbool(undoManager(id).CanRedo())
+}
+
+//export goUndoManagerUndo
+func goUndoManagerUndo(id C.guintptr) {
+	undoManager(id).Undo()
+}
+
+//export goUndoManagerRedo
+func goUndoManagerRedo(id C.guintptr) {
+	undoManager(id).Redo()
+}
+
+//export goUndoManagerBeginNotUndoableAction
+func goUndoManagerBeginNotUndoableAction(id C.guintptr) {
+	undoManager(id).BeginNotUndoableAction()
+}
+
+//export goUndoManagerEndNotUndoableAction
+func goUndoManagerEndNotUndoableAction(id C.guintptr) {
+	undoManager(id).EndNotUndoableAction()
+}
+
+//export goUndoManagerFinalize
+func goUndoManagerFinalize(id C.guintptr) {
+	deleteCallback(uintptr(id))
+}
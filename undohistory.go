@@ -0,0 +1,256 @@
+package sourceview
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+// SourceUndoOperationKind tells whether a SourceUndoOperation inserted or
+// deleted text.
+type SourceUndoOperationKind string
+
+const (
+	SOURCE_UNDO_OPERATION_INSERT SourceUndoOperationKind = "insert"
+	SOURCE_UNDO_OPERATION_DELETE SourceUndoOperationKind = "delete"
+)
+
+// SourceUndoOperation is a single change recorded by a SourceUndoHistory.
+// Offset is the character offset in the buffer where Text was inserted or
+// from where it was deleted.
+type SourceUndoOperation struct {
+	Kind   SourceUndoOperationKind `json:"kind"`
+	Offset int                     `json:"offset"`
+	Text   string                  `json:"text"`
+}
+
+// SourceUndoAction is the list of operations undone or redone as one step,
+// usually everything done inside one user action.
+type SourceUndoAction []SourceUndoOperation
+
+// SourceUndoHistory is a SourceUndoManager written in Go. It records the
+// text inserted into and deleted from a buffer, and its undo and redo stacks
+// can be inspected and saved with json.Marshal, then restored with
+// json.Unmarshal once the same text has been loaded again.
+//
+// A SourceUndoHistory does not keep its buffer alive and must not be used
+// after the buffer has been finalized.
+type SourceUndoHistory struct {
+	buffer unsafe.Pointer
+
+	undo, redo []SourceUndoAction
+	current    SourceUndoAction
+
+	userActionDepth  int
+	notUndoableDepth int
+	replaying        bool
+}
+
+// SourceUndoHistoryNew creates a SourceUndoHistory recording the changes
+// made to buffer. It still has to be installed with buffer.SetUndoManager.
+func SourceUndoHistoryNew(buffer *SourceBuffer) *SourceUndoHistory {
+	h := &SourceUndoHistory{buffer: unsafe.Pointer(buffer.GObject)}
+
+	buffer.Connect("insert-text", func(_ interface{}, location *gtk.TextIter, text string) {
+		h.record(SourceUndoOperation{SOURCE_UNDO_OPERATION_INSERT, location.GetOffset(), text})
+	})
+	buffer.Connect("delete-range", func(b *SourceBuffer, start, end *gtk.TextIter) {
+		text, err := b.GetText(start, end, true)
+		if err != nil {
+			return
+		}
+		h.record(SourceUndoOperation{SOURCE_UNDO_OPERATION_DELETE, start.GetOffset(), text})
+	})
+	buffer.Connect("begin-user-action", func(_ interface{}) {
+		if h.replaying {
+			return
+		}
+		h.userActionDepth++
+	})
+	buffer.Connect("end-user-action", func(_ interface{}) {
+		if h.replaying || h.userActionDepth == 0 {
+			return
+		}
+		h.userActionDepth--
+		if h.userActionDepth == 0 && len(h.current) > 0 {
+			h.update(func() {
+				h.undo = append(h.undo, h.current)
+				h.current = nil
+			})
+		}
+	})
+	return h
+}
+
+// textBuffer returns a temporary wrapper around the buffer.
+func (h *SourceUndoHistory) textBuffer() *SourceBuffer {
+	return wrapSourceBuffer(glib.Take(h.buffer))
+}
+
+// record adds op to the current user action, or as an action of its own
+// outside of user actions. Recording a change clears the redo stack.
+func (h *SourceUndoHistory) record(op SourceUndoOperation) {
+	if h.replaying || h.notUndoableDepth > 0 {
+		return
+	}
+
+	h.update(func() {
+		h.redo = nil
+		if h.userActionDepth > 0 {
+			h.current = append(h.current, op)
+			return
+		}
+		h.undo = append(h.undo, SourceUndoAction{op})
+	})
+}
+
+// update runs f and emits the change notifications for whatever it changed.
+func (h *SourceUndoHistory) update(f func()) {
+	canUndo, canRedo := h.CanUndo(), h.CanRedo()
+	f()
+	if h.CanUndo() != canUndo {
+		SourceUndoManagerCanUndoChanged(h)
+	}
+	if h.CanRedo() != canRedo {
+		SourceUndoManagerCanRedoChanged(h)
+	}
+}
+
+// apply performs op on the buffer, or its inverse if reverse is true, and
+// returns the offset where the cursor should be placed.
+func (h *SourceUndoHistory) apply(buffer *SourceBuffer, op SourceUndoOperation, reverse bool) int {
+	insert := op.Kind == SOURCE_UNDO_OPERATION_INSERT
+	if reverse {
+		insert = !insert
+	}
+
+	start := buffer.GetIterAtOffset(op.Offset)
+	if insert {
+		buffer.Insert(start, op.Text)
+		return op.Offset + utf8.RuneCountInString(op.Text)
+	}
+	end := buffer.GetIterAtOffset(op.Offset + utf8.RuneCountInString(op.Text))
+	buffer.Delete(start, end)
+	return op.Offset
+}
+
+// CanUndo reports whether there is an action to undo.
+func (h *SourceUndoHistory) CanUndo() bool {
+	return len(h.undo) > 0
+}
+
+// CanRedo reports whether there is an undone action to redo.
+func (h *SourceUndoHistory) CanRedo() bool {
+	return len(h.redo) > 0
+}
+
+// Undo reverts the last action and moves it to the redo stack.
+func (h *SourceUndoHistory) Undo() {
+	if !h.CanUndo() {
+		return
+	}
+
+	buffer := h.textBuffer()
+	h.replaying = true
+	defer func() { h.replaying = false }()
+
+	h.update(func() {
+		action := h.undo[len(h.undo)-1]
+		h.undo = h.undo[:len(h.undo)-1]
+
+		cursor := 0
+		for i := len(action) - 1; i >= 0; i-- {
+			cursor = h.apply(buffer, action[i], true)
+		}
+		buffer.PlaceCursor(buffer.GetIterAtOffset(cursor))
+
+		h.redo = append(h.redo, action)
+	})
+}
+
+// Redo performs the last undone action again and moves it back to the undo
+// stack.
+func (h *SourceUndoHistory) Redo() {
+	if !h.CanRedo() {
+		return
+	}
+
+	buffer := h.textBuffer()
+	h.replaying = true
+	defer func() { h.replaying = false }()
+
+	h.update(func() {
+		action := h.redo[len(h.redo)-1]
+		h.redo = h.redo[:len(h.redo)-1]
+
+		cursor := 0
+		for _, op := range action {
+			cursor = h.apply(buffer, op, false)
+		}
+		buffer.PlaceCursor(buffer.GetIterAtOffset(cursor))
+
+		h.undo = append(h.undo, action)
+	})
+}
+
+// BeginNotUndoableAction stops recording changes until the matching
+// EndNotUndoableAction.
+func (h *SourceUndoHistory) BeginNotUndoableAction() {
+	h.notUndoableDepth++
+}
+
+// EndNotUndoableAction resumes recording changes. Since the recorded
+// offsets no longer match the text, both stacks are cleared.
+func (h *SourceUndoHistory) EndNotUndoableAction() {
+	if h.notUndoableDepth == 0 {
+		return
+	}
+	h.notUndoableDepth--
+	if h.notUndoableDepth == 0 {
+		h.Clear()
+	}
+}
+
+// Clear empties both stacks.
+func (h *SourceUndoHistory) Clear() {
+	h.update(func() {
+		h.undo, h.redo, h.current = nil, nil, nil
+	})
+}
+
+// UndoActions returns the undo stack, oldest action first.
+func (h *SourceUndoHistory) UndoActions() []SourceUndoAction {
+	return append([]SourceUndoAction(nil), h.undo...)
+}
+
+// RedoActions returns the redo stack, oldest action first.
+func (h *SourceUndoHistory) RedoActions() []SourceUndoAction {
+	return append([]SourceUndoAction(nil), h.redo...)
+}
+
+// sourceUndoHistoryJSON is the serialized form of a SourceUndoHistory.
+type sourceUndoHistoryJSON struct {
+	Undo []SourceUndoAction `json:"undo"`
+	Redo []SourceUndoAction `json:"redo"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (h *SourceUndoHistory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(sourceUndoHistoryJSON{h.undo, h.redo})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces both stacks; the
+// buffer must hold the text the history was recorded against.
+func (h *SourceUndoHistory) UnmarshalJSON(data []byte) error {
+	var v sourceUndoHistoryJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	h.update(func() {
+		h.undo, h.redo, h.current = v.Undo, v.Redo, nil
+	})
+	return nil
+}
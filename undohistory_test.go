@@ -0,0 +1,157 @@
+package sourceview
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// newTestHistory returns a buffer holding text with a SourceUndoHistory
+// installed as its undo manager.
+func newTestHistory(t *testing.T, text string) (*SourceBuffer, *SourceUndoHistory) {
+	t.Helper()
+	buffer := newTestBuffer(t, text)
+	history := SourceUndoHistoryNew(buffer)
+	buffer.SetUndoManager(history)
+	return buffer, history
+}
+
+func TestSourceUndoHistoryUndoRedo(t *testing.T) {
+	requireGTK(t)
+	buffer, history := newTestHistory(t, "")
+
+	if manager, err := buffer.GetUndoManager(); err != nil || manager != SourceUndoManager(history) {
+		t.Fatalf("GetUndoManager() = %v, %v, want the installed history", manager, err)
+	}
+
+	var canUndo []bool
+	buffer.OnCanUndoChanged(func(v bool) { canUndo = append(canUndo, v) })
+
+	buffer.WithUserAction(func() {
+		buffer.Insert(buffer.GetEndIter(), "hello")
+		buffer.Insert(buffer.GetEndIter(), " world")
+	})
+	buffer.Insert(buffer.GetEndIter(), "!")
+
+	want := []SourceUndoAction{
+		{{SOURCE_UNDO_OPERATION_INSERT, 0, "hello"}, {SOURCE_UNDO_OPERATION_INSERT, 5, " world"}},
+		{{SOURCE_UNDO_OPERATION_INSERT, 11, "!"}},
+	}
+	if got := history.UndoActions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("UndoActions() = %v, want %v", got, want)
+	}
+	if !buffer.CanUndo() || buffer.CanRedo() {
+		t.Errorf("CanUndo(), CanRedo() = %v, %v, want true, false", buffer.CanUndo(), buffer.CanRedo())
+	}
+	if len(canUndo) == 0 || !canUndo[len(canUndo)-1] {
+		t.Errorf("can-undo notifications = %v, want the last one to be true", canUndo)
+	}
+
+	buffer.Undo()
+	if got := bufferText(t, buffer); got != "hello world" {
+		t.Errorf("text after one Undo() = %q, want %q", got, "hello world")
+	}
+	buffer.Undo()
+	if got := bufferText(t, buffer); got != "" {
+		t.Errorf("text after two Undo() = %q, want \"\"", got)
+	}
+	if buffer.CanUndo() || !buffer.CanRedo() {
+		t.Errorf("CanUndo(), CanRedo() = %v, %v after undoing everything, want false, true", buffer.CanUndo(), buffer.CanRedo())
+	}
+	if got := history.UndoActions(); len(got) != 0 {
+		t.Errorf("UndoActions() = %v after undoing everything, want none", got)
+	}
+
+	buffer.Redo()
+	if got := bufferText(t, buffer); got != "hello world" {
+		t.Errorf("text after Redo() = %q, want %q", got, "hello world")
+	}
+	if got := history.RedoActions(); !reflect.DeepEqual(got, want[1:]) {
+		t.Errorf("RedoActions() = %v, want %v", got, want[1:])
+	}
+
+	// A new change clears the redo stack.
+	buffer.Insert(buffer.GetEndIter(), "?")
+	if buffer.CanRedo() {
+		t.Error("CanRedo() = true after a new change")
+	}
+}
+
+func TestSourceUndoHistoryDelete(t *testing.T) {
+	requireGTK(t)
+	buffer, history := newTestHistory(t, "hello world")
+
+	buffer.Delete(buffer.GetIterAtOffset(5), buffer.GetEndIter())
+	want := []SourceUndoAction{{{SOURCE_UNDO_OPERATION_DELETE, 5, " world"}}}
+	if got := history.UndoActions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("UndoActions() = %v, want %v", got, want)
+	}
+
+	history.Undo()
+	if got := bufferText(t, buffer); got != "hello world" {
+		t.Errorf("text after Undo() = %q, want %q", got, "hello world")
+	}
+	history.Redo()
+	if got := bufferText(t, buffer); got != "hello" {
+		t.Errorf("text after Redo() = %q, want %q", got, "hello")
+	}
+}
+
+func TestSourceUndoHistoryNotUndoable(t *testing.T) {
+	requireGTK(t)
+	buffer, history := newTestHistory(t, "")
+
+	buffer.Insert(buffer.GetEndIter(), "undoable")
+	buffer.WithNotUndoable(func() {
+		buffer.SetText("loaded")
+	})
+	if buffer.CanUndo() || len(history.UndoActions()) != 0 {
+		t.Errorf("CanUndo() = %v, %d actions after a not undoable action, want false, 0",
+			buffer.CanUndo(), len(history.UndoActions()))
+	}
+
+	buffer.Insert(buffer.GetEndIter(), "!")
+	history.Clear()
+	if history.CanUndo() || history.CanRedo() {
+		t.Error("Clear() left actions to undo or redo")
+	}
+}
+
+func TestSourceUndoHistoryJSON(t *testing.T) {
+	requireGTK(t)
+	buffer, history := newTestHistory(t, "")
+
+	buffer.Insert(buffer.GetEndIter(), "one")
+	buffer.Insert(buffer.GetEndIter(), " two")
+	buffer.Undo()
+	data, err := json.Marshal(history)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"undo":[[{"kind":"insert","offset":0,"text":"one"}]],"redo":[[{"kind":"insert","offset":3,"text":" two"}]]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	// Restore the history on a new buffer holding the same text.
+	restored, restoredHistory := newTestHistory(t, bufferText(t, buffer))
+	if err := json.Unmarshal(data, restoredHistory); err != nil {
+		t.Fatal(err)
+	}
+	if !restored.CanUndo() || !restored.CanRedo() {
+		t.Fatalf("CanUndo(), CanRedo() = %v, %v after json.Unmarshal, want true, true", restored.CanUndo(), restored.CanRedo())
+	}
+	restored.Redo()
+	if got := bufferText(t, restored); got != "one two" {
+		t.Errorf("text after Redo() = %q, want %q", got, "one two")
+	}
+	restored.Undo()
+	restored.Undo()
+	if got := bufferText(t, restored); got != "" {
+		t.Errorf("text after undoing everything = %q, want \"\"", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"undo":`), restoredHistory); err == nil {
+		t.Error("json.Unmarshal() of truncated data succeeded")
+	}
+}
@@ -0,0 +1,148 @@
+package sourceview
+
+// #include <stdlib.h>
+// #include <gtksourceview/gtksource.h>
+// #include "words.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/gotk3/gotk3/gdk"
+	"github.com/gotk3/gotk3/glib"
+	"github.com/gotk3/gotk3/gtk"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		{glib.Type(C.gtk_source_completion_words_get_type()), marshalSourceCompletionWords},
+	}
+	glib.RegisterGValueMarshalers(tm)
+
+	gtk.WrapMap["GtkSourceCompletionWords"] = wrapSourceCompletionWords
+}
+
+/*
+ * GtkSourceCompletionWords
+ */
+
+// SourceCompletionWords is a representation of GtkSourceCompletionWords, a
+// completion provider proposing the words found in the registered buffers.
+// It implements SourceCompletionProvider.
+type SourceCompletionWords struct {
+	sourceCompletionProvider
+}
+
+// native returns a pointer to the underlying GtkSourceCompletionWords.
+func (v *SourceCompletionWords) native() *C.GtkSourceCompletionWords {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGtkSourceCompletionWords(p)
+}
+
+func marshalSourceCompletionWords(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapSourceCompletionWords(obj), nil
+}
+
+func wrapSourceCompletionWords(obj *glib.Object) *SourceCompletionWords {
+	return &SourceCompletionWords{sourceCompletionProvider{obj}}
+}
+
+// SourceCompletionWordsNew is a wrapper around gtk_source_completion_words_new().
+// An empty name uses the default "Document Words"; icon may be nil.
+func SourceCompletionWordsNew(name string, icon *gdk.Pixbuf) (*SourceCompletionWords, error) {
+	cstr := cstringOrNil(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var cicon *C.GdkPixbuf
+	if icon != nil {
+		cicon = (*C.GdkPixbuf)(unsafe.Pointer(icon.Native()))
+	}
+
+	c := C.gtk_source_completion_words_new(cstr, cicon)
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSourceCompletionWords(glib.AssumeOwnership(unsafe.Pointer(c))), nil
+}
+
+// Register is a wrapper around gtk_source_completion_words_register().
+// Words from all the registered buffers are proposed.
+func (v *SourceCompletionWords) Register(buffer *SourceBuffer) {
+	C.gtk_source_completion_words_register(v.native(), buffer.asTextBuffer())
+}
+
+// Unregister is a wrapper around gtk_source_completion_words_unregister().
+func (v *SourceCompletionWords) Unregister(buffer *SourceBuffer) {
+	C.gtk_source_completion_words_unregister(v.native(), buffer.asTextBuffer())
+}
+
+func (v *SourceCompletionWords) setUint(name string, value uint) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	C.completion_words_set_uint(v.native(), (*C.gchar)(cname), C.guint(value))
+}
+
+func (v *SourceCompletionWords) getUint(name string) uint {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return uint(C.completion_words_get_uint(v.native(), (*C.gchar)(cname)))
+}
+
+func (v *SourceCompletionWords) setInt(name string, value int) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	C.completion_words_set_int(v.native(), (*C.gchar)(cname), C.gint(value))
+}
+
+// SetMinimumWordSize sets the "minimum-word-size" property, the number of
+// characters a word needs to be proposed.
+func (v *SourceCompletionWords) SetMinimumWordSize(size uint) {
+	v.setUint("minimum-word-size", size)
+}
+
+// GetMinimumWordSize returns the "minimum-word-size" property.
+func (v *SourceCompletionWords) GetMinimumWordSize() uint {
+	return v.getUint("minimum-word-size")
+}
+
+// SetProposalsBatchSize sets the "proposals-batch-size" property, the
+// number of proposals added to the completion in one go.
+func (v *SourceCompletionWords) SetProposalsBatchSize(size uint) {
+	v.setUint("proposals-batch-size", size)
+}
+
+// GetProposalsBatchSize returns the "proposals-batch-size" property.
+func (v *SourceCompletionWords) GetProposalsBatchSize() uint {
+	return v.getUint("proposals-batch-size")
+}
+
+// SetScanBatchSize sets the "scan-batch-size" property, the number of lines
+// scanned for words in one idle iteration.
+func (v *SourceCompletionWords) SetScanBatchSize(size uint) {
+	v.setUint("scan-batch-size", size)
+}
+
+// GetScanBatchSize returns the "scan-batch-size" property.
+func (v *SourceCompletionWords) GetScanBatchSize() uint {
+	return v.getUint("scan-batch-size")
+}
+
+// SetInteractiveDelay sets the "interactive-delay" property, in
+// milliseconds. A value of -1 uses the default delay of the completion.
+func (v *SourceCompletionWords) SetInteractiveDelay(delay int) {
+	v.setInt("interactive-delay", delay)
+}
+
+// SetPriority sets the "priority" property.
+func (v *SourceCompletionWords) SetPriority(priority int) {
+	v.setInt("priority", priority)
+}
+
+// SetActivation sets the "activation" property.
+func (v *SourceCompletionWords) SetActivation(activation SourceCompletionActivation) {
+	C.completion_words_set_activation(v.native(), C.GtkSourceCompletionActivation(activation))
+}
@@ -0,0 +1,33 @@
+#include <gtk/gtk.h>
+
+static GtkSourceCompletionWords *
+toGtkSourceCompletionWords(void *p)
+{
+	return (GTK_SOURCE_COMPLETION_WORDS(p));
+}
+
+static void
+completion_words_set_uint(GtkSourceCompletionWords *words, const gchar *name, guint value)
+{
+	g_object_set(words, name, value, NULL);
+}
+
+static guint
+completion_words_get_uint(GtkSourceCompletionWords *words, const gchar *name)
+{
+	guint value = 0;
+	g_object_get(words, name, &value, NULL);
+	return value;
+}
+
+static void
+completion_words_set_int(GtkSourceCompletionWords *words, const gchar *name, gint value)
+{
+	g_object_set(words, name, value, NULL);
+}
+
+static void
+completion_words_set_activation(GtkSourceCompletionWords *words, GtkSourceCompletionActivation activation)
+{
+	g_object_set(words, "activation", activation, NULL);
+}
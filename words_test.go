@@ -0,0 +1,77 @@
+package sourceview
+
+import (
+	"testing"
+)
+
+func TestSourceCompletionWords(t *testing.T) {
+	requireGTK(t)
+
+	words, err := SourceCompletionWordsNew("Buffer Words", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := words.GetName(); got != "Buffer Words" {
+		t.Errorf("GetName() = %q, want %q", got, "Buffer Words")
+	}
+
+	words.SetMinimumWordSize(4)
+	words.SetProposalsBatchSize(50)
+	words.SetScanBatchSize(20)
+	for _, tt := range []struct {
+		name      string
+		got, want uint
+	}{
+		{"GetMinimumWordSize", words.GetMinimumWordSize(), 4},
+		{"GetProposalsBatchSize", words.GetProposalsBatchSize(), 50},
+		{"GetScanBatchSize", words.GetScanBatchSize(), 20},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s() = %d, want %d", tt.name, tt.got, tt.want)
+		}
+	}
+
+	words.SetInteractiveDelay(100)
+	words.SetPriority(7)
+	words.SetActivation(SOURCE_COMPLETION_ACTIVATION_USER_REQUESTED)
+	if got := words.GetInteractiveDelay(); got != 100 {
+		t.Errorf("GetInteractiveDelay() = %d, want 100", got)
+	}
+	if got := words.GetPriority(); got != 7 {
+		t.Errorf("GetPriority() = %d, want 7", got)
+	}
+	if got := words.GetActivation(); got != SOURCE_COMPLETION_ACTIVATION_USER_REQUESTED {
+		t.Errorf("GetActivation() = %v, want SOURCE_COMPLETION_ACTIVATION_USER_REQUESTED", got)
+	}
+}
+
+func TestSourceCompletionWordsProvider(t *testing.T) {
+	requireGTK(t)
+
+	buffer := newTestBuffer(t, "alpha alphabet beta")
+	words, err := SourceCompletionWordsNew("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words.Register(buffer)
+	defer words.Unregister(buffer)
+
+	view, err := SourceViewNewWithBuffer(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	completion, err := view.GetCompletion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := completion.AddProvider(words); err != nil {
+		t.Fatal(err)
+	}
+	providers := completion.GetProviders()
+	if len(providers) != 1 || providers[0].GetName() != words.GetName() {
+		t.Fatalf("GetProviders() = %v, want the words provider", providers)
+	}
+	if err := completion.RemoveProvider(providers[0]); err != nil {
+		t.Errorf("RemoveProvider() of the provider returned by GetProviders() = %v", err)
+	}
+}